@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/aaguid"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/api"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/auth"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/inspect"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/store"
+)
+
+// InspectAttestation implements api.Handler.
+func (hdl *Handler) InspectAttestation(ctx context.Context, params api.InspectAttestationParams) (api.InspectAttestationRes, error) {
+	ss, ok := auth.FromContext(ctx)
+	if !ok {
+		return &api.InspectAttestationUnauthorized{
+			Message: "login is required",
+		}, nil
+	}
+
+	// NOTE: ユーザー名は登録時に誰でも名乗れるので、変わらないユーザーハンドルで判定する
+	if !slices.ContainsFunc(hdl.admins, func(id []byte) bool { return bytes.Equal(id, ss.UserID) }) {
+		return &api.InspectAttestationForbidden{
+			Message: "admin is required",
+		}, nil
+	}
+
+	id, err := base64.RawURLEncoding.DecodeString(params.CredentialId)
+	if err != nil {
+		return &api.InspectAttestationNotFound{
+			Message: fmt.Sprintf("credential %s is not found", params.CredentialId),
+		}, nil
+	}
+
+	cred, err := hdl.store.FindCredential(ctx, id)
+	if errors.Is(err, store.ErrNotFound) {
+		return &api.InspectAttestationNotFound{
+			Message: fmt.Sprintf("credential %s is not found", params.CredentialId),
+		}, nil
+	}
+	if err != nil {
+		return &api.InspectAttestationInternalServerError{
+			Message: fmt.Sprintf("failed to find credential. error: %s", err),
+		}, nil
+	}
+
+	// NOTE: 保存する前に登録されたクレデンシャルには attestation が残っていない
+	if len(cred.AttestationObject) == 0 {
+		return &api.InspectAttestationNotFound{
+			Message: fmt.Sprintf("attestation of credential %s is not kept", params.CredentialId),
+		}, nil
+	}
+
+	rep, err := inspect.Attestation(cred.AttestationObject, cred.ClientDataJSON)
+	if err != nil {
+		return &api.InspectAttestationInternalServerError{
+			Message: fmt.Sprintf("failed to inspect attestation. error: %s", err),
+		}, nil
+	}
+
+	res := &api.AttestationReport{
+		Format:   rep.Format,
+		RpIdHash: hex.EncodeToString(rep.RPIDHash),
+		Flags: api.AuthenticatorFlags{
+			UserPresent:            rep.Flags.HasUserPresent(),
+			UserVerified:           rep.Flags.HasUserVerified(),
+			BackupEligible:         rep.Flags.HasBackupEligible(),
+			BackupState:            rep.Flags.HasBackupState(),
+			AttestedCredentialData: rep.Flags.HasAttestedCredentialData(),
+			ExtensionData:          rep.Flags.HasExtensions(),
+		},
+		Aaguid:       aaguid.Format(rep.AAGUID),
+		SignCount:    int64(rep.SignCount),
+		CredentialId: base64.RawURLEncoding.EncodeToString(rep.CredentialID),
+		PublicKey: api.CoseKey{
+			Kty:  rep.PublicKey.KeyType,
+			Alg:  rep.PublicKey.Algorithm,
+			Size: rep.PublicKey.Size,
+		},
+		Certificates: make([]api.AttestationCertificate, 0, len(rep.Certificates)),
+		ClientData: api.ClientData{
+			Type:      rep.ClientData.Type,
+			Challenge: rep.ClientData.Challenge,
+			Origin:    rep.ClientData.Origin,
+		},
+	}
+
+	if rep.PublicKey.Curve != 0 {
+		res.PublicKey.Crv = api.NewOptInt64(rep.PublicKey.Curve)
+	}
+
+	for _, cert := range rep.Certificates {
+		res.Certificates = append(res.Certificates, api.AttestationCertificate{
+			Subject:     cert.Subject,
+			Issuer:      cert.Issuer,
+			Serial:      cert.Serial,
+			NotBefore:   cert.NotBefore,
+			NotAfter:    cert.NotAfter,
+			Fingerprint: cert.Fingerprint,
+		})
+	}
+
+	if len(rep.Extensions) > 0 {
+		ext := make(api.AttestationReportExtensions, len(rep.Extensions))
+
+		for key, value := range rep.Extensions {
+			raw, err := json.Marshal(value)
+			if err != nil {
+				return &api.InspectAttestationInternalServerError{
+					Message: fmt.Sprintf("failed to marshal extension %s. error: %s", key, err),
+				}, nil
+			}
+
+			ext[key] = raw
+		}
+
+		res.Extensions = api.NewOptAttestationReportExtensions(ext)
+	}
+
+	if rep.ClientData.CrossOrigin {
+		res.ClientData.CrossOrigin = api.NewOptBool(true)
+	}
+
+	if rep.ClientData.TopOrigin != "" {
+		res.ClientData.TopOrigin = api.NewOptString(rep.ClientData.TopOrigin)
+	}
+
+	return res, nil
+}
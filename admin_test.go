@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/api"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/auth"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/store"
+)
+
+func TestInspectAttestationAuthorization(t *testing.T) {
+	ctx := context.Background()
+
+	st := store.NewMemory()
+
+	admin := store.User{ID: []byte("admin-handle"), Name: "admin"}
+
+	// NOTE: 管理者と紛らわしい名前で登録したユーザーも管理者として扱わない
+	impostor := store.User{ID: []byte("impostor-handle"), Name: "admin-handle"}
+
+	for _, user := range []store.User{admin, impostor} {
+		if err := st.CreateUser(ctx, user); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	hdl := &Handler{
+		store:  st,
+		admins: [][]byte{admin.ID},
+	}
+
+	tests := []struct {
+		name    string
+		session *auth.Session
+		want    api.InspectAttestationRes
+	}{
+		{
+			name:    "admin",
+			session: &auth.Session{UserID: admin.ID},
+			want:    &api.InspectAttestationNotFound{},
+		},
+		{
+			name:    "other user",
+			session: &auth.Session{UserID: impostor.ID},
+			want:    &api.InspectAttestationForbidden{},
+		},
+		{
+			name: "not logged in",
+			want: &api.InspectAttestationUnauthorized{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := ctx
+
+			if tt.session != nil {
+				ctx = auth.WithSession(ctx, tt.session)
+			}
+
+			res, err := hdl.InspectAttestation(ctx, api.InspectAttestationParams{CredentialId: "AAAA"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := typeName(res), typeName(tt.want); got != want {
+				t.Errorf("response = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func typeName(v interface{}) string {
+	return fmt.Sprintf("%T", v)
+}
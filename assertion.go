@@ -0,0 +1,34 @@
+package main
+
+import (
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/api"
+)
+
+// conditionalTimeout is how long a conditional mediation challenge stays valid. It is much longer than the default
+// login timeout because the request waits in the background until the user picks a passkey from autofill.
+const conditionalTimeout = 10 * time.Minute
+
+func isConditional(mediation api.OptMediation) bool {
+	return mediation.Or(api.MediationOptional) == api.MediationConditional
+}
+
+// assertionCookieName returns the name of the cookie holding the assertion session.
+func assertionCookieName(mediation api.OptMediation) string {
+	if isConditional(mediation) {
+		return "conditional_session"
+	}
+
+	return "session"
+}
+
+// withTimeout overrides the timeout of the credential request options.
+func withTimeout(timeout time.Duration) webauthn.LoginOption {
+	return func(options *protocol.PublicKeyCredentialRequestOptions) {
+		options.Timeout = int(timeout.Milliseconds())
+	}
+}
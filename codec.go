@@ -0,0 +1,300 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/ogen-go/ogen/middleware"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/api"
+)
+
+// format is the serialization of the ceremony messages.
+//
+// Binary fields are base64url strings in JSON, raw bytes in MessagePack and byte strings in CBOR.
+type format string
+
+const (
+	formatJSON    format = "application/json"
+	formatMsgpack format = "application/x-msgpack"
+	formatCBOR    format = "application/cbor"
+)
+
+// formats are the supported formats in the order of preference when the client accepts several equally.
+var formats = []format{formatMsgpack, formatJSON, formatCBOR}
+
+var errNotAcceptable = errors.New("none of the accepted media types is supported")
+
+type acceptKey struct{}
+
+// acceptMiddleware keeps the Accept header in the context so that the handlers can negotiate the format of the
+// options they respond.
+func acceptMiddleware(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+	req.Context = context.WithValue(req.Context, acceptKey{}, req.Raw.Header.Get("Accept"))
+
+	return next(req)
+}
+
+// negotiate returns the format of the response for the Accept header of the request. MessagePack is chosen when the
+// header is missing or accepts anything, as the ceremonies always responded it.
+//
+// Each format takes the weight of the most specific media range matching it, so that a format refused by q=0 is not
+// chosen by a wildcard.
+func negotiate(ctx context.Context) (format, error) {
+	accept, _ := ctx.Value(acceptKey{}).(string)
+	if strings.TrimSpace(accept) == "" {
+		return formatMsgpack, nil
+	}
+
+	type mediaRange struct {
+		mediaType string
+		q         float64
+	}
+
+	var ranges []mediaRange
+
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		q := 1.0
+
+		if value, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(value, 64); err != nil {
+				continue
+			}
+		}
+
+		ranges = append(ranges, mediaRange{mediaType: mediaType, q: q})
+	}
+
+	var (
+		chosen    format
+		bestQ     float64
+		bestIndex int
+	)
+
+	for _, f := range formats {
+		q, index, specificity := 0.0, 0, 0
+
+		for i, r := range ranges {
+			if s := matchMediaRange(r.mediaType, f); s > specificity {
+				q, index, specificity = r.q, i, s
+			}
+		}
+
+		if q <= 0 {
+			continue
+		}
+
+		// NOTE: 同じ重みなら先に書かれた方を優先する
+		if q > bestQ || (q == bestQ && index < bestIndex) {
+			chosen, bestQ, bestIndex = f, q, index
+		}
+	}
+
+	if chosen == "" {
+		return "", errNotAcceptable
+	}
+
+	return chosen, nil
+}
+
+// matchMediaRange returns how specifically the media range matches the format: 3 for the media type itself, 2 for
+// its type with a wildcard subtype, 1 for */* and 0 when it does not match.
+func matchMediaRange(mediaRange string, f format) int {
+	typ, _, _ := strings.Cut(string(f), "/")
+
+	switch mediaRange {
+	case string(f):
+		return 3
+	case typ + "/*":
+		return 2
+	case "*/*":
+		return 1
+	default:
+		return 0
+	}
+}
+
+// encode serializes the options in the format by their json tags.
+func encode(f format, v interface{}) ([]byte, error) {
+	switch f {
+	case formatJSON:
+		return json.Marshal(v)
+	case formatMsgpack:
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+
+		enc.SetCustomStructTag("json")
+
+		if err := enc.Encode(v); err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), nil
+	case formatCBOR:
+		return cbor.Marshal(v)
+	default:
+		return nil, fmt.Errorf("unsupported format %s", f)
+	}
+}
+
+// creationOptionsResponse returns the response of InitializeAttestation with the options in the format.
+func creationOptionsResponse(f format, options protocol.PublicKeyCredentialCreationOptions, cookie string) (api.InitializeAttestationRes, error) {
+	body, err := encode(f, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode credential creation options. error: %w", err)
+	}
+
+	switch f {
+	case formatJSON:
+		return &api.PublicKeyCredentialCreationOptionsHeaders{
+			SetCookie: api.NewOptString(cookie),
+			Response:  api.PublicKeyCredentialCreationOptions(body),
+		}, nil
+	case formatCBOR:
+		return &api.InitializeAttestationOKApplicationCborHeaders{
+			SetCookie: api.NewOptString(cookie),
+			Response: api.InitializeAttestationOKApplicationCbor{
+				Data: bytes.NewReader(body),
+			},
+		}, nil
+	default:
+		return &api.InitializeAttestationOKApplicationXMsgpackHeaders{
+			SetCookie: api.NewOptString(cookie),
+			Response: api.InitializeAttestationOKApplicationXMsgpack{
+				Data: bytes.NewReader(body),
+			},
+		}, nil
+	}
+}
+
+// requestOptionsResponse returns the response of InitializeAssertion with the options in the format.
+func requestOptionsResponse(f format, options protocol.PublicKeyCredentialRequestOptions, cookie string) (api.InitializeAssertionRes, error) {
+	body, err := encode(f, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode credential request options. error: %w", err)
+	}
+
+	switch f {
+	case formatJSON:
+		return &api.PublicKeyCredentialRequestOptionsHeaders{
+			SetCookie: api.NewOptString(cookie),
+			Response:  api.PublicKeyCredentialRequestOptions(body),
+		}, nil
+	case formatCBOR:
+		return &api.InitializeAssertionOKApplicationCborHeaders{
+			SetCookie: api.NewOptString(cookie),
+			Response: api.InitializeAssertionOKApplicationCbor{
+				Data: bytes.NewReader(body),
+			},
+		}, nil
+	default:
+		return &api.InitializeAssertionOKApplicationXMsgpackHeaders{
+			SetCookie: api.NewOptString(cookie),
+			Response: api.InitializeAssertionOKApplicationXMsgpack{
+				Data: bytes.NewReader(body),
+			},
+		}, nil
+	}
+}
+
+// parseCredentialCreation parses the body of FinalizeAttestation in the format of its Content-Type. JSON is also
+// accepted as text/plain for older clients.
+func parseCredentialCreation(req api.FinalizeAttestationReq) (*protocol.ParsedCredentialCreationData, error) {
+	var ccr protocol.CredentialCreationResponse
+
+	switch req := req.(type) {
+	case *api.FinalizeAttestationRequest:
+		body, err := req.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request. error: %w", err)
+		}
+
+		return protocol.ParseCredentialCreationResponseBody(bytes.NewReader(body))
+	case *api.FinalizeAttestationReqTextPlain:
+		return protocol.ParseCredentialCreationResponseBody(req.Data)
+	case *api.FinalizeAttestationReqApplicationXMsgpack:
+		if err := decode(formatMsgpack, req.Data, &ccr); err != nil {
+			return nil, err
+		}
+	case *api.FinalizeAttestationReqApplicationCbor:
+		if err := decode(formatCBOR, req.Data, &ccr); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported request %T", req)
+	}
+
+	return ccr.Parse()
+}
+
+// parseCredentialAssertion parses the body of FinalizeAssertion in the format of its Content-Type.
+func parseCredentialAssertion(req api.FinalizeAssertionReq) (*protocol.ParsedCredentialAssertionData, error) {
+	var car protocol.CredentialAssertionResponse
+
+	switch req := req.(type) {
+	case *api.FinalizeAssertionRequest:
+		body, err := req.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request. error: %w", err)
+		}
+
+		return protocol.ParseCredentialRequestResponseBody(bytes.NewReader(body))
+	case *api.FinalizeAssertionReqApplicationXMsgpack:
+		if err := decode(formatMsgpack, req.Data, &car); err != nil {
+			return nil, err
+		}
+	case *api.FinalizeAssertionReqApplicationCbor:
+		if err := decode(formatCBOR, req.Data, &car); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported request %T", req)
+	}
+
+	return car.Parse()
+}
+
+// cborDecMode decodes maps of unknown type, such as the client extension outputs, into map[string]interface{} as
+// encoding/json and MessagePack do.
+var cborDecMode, _ = cbor.DecOptions{
+	DefaultMapType: reflect.TypeOf(map[string]interface{}(nil)),
+}.DecMode()
+
+// decode decodes MessagePack or CBOR into the credential response types of protocol by their json tags.
+func decode(f format, r io.Reader, v interface{}) error {
+	switch f {
+	case formatMsgpack:
+		dec := msgpack.NewDecoder(r)
+
+		dec.SetCustomStructTag("json")
+
+		if err := dec.Decode(v); err != nil {
+			return fmt.Errorf("failed to decode MessagePack. error: %w", err)
+		}
+	case formatCBOR:
+		if err := cborDecMode.NewDecoder(r).Decode(v); err != nil {
+			return fmt.Errorf("failed to decode CBOR. error: %w", err)
+		}
+	default:
+		return fmt.Errorf("unsupported format %s", f)
+	}
+
+	return nil
+}
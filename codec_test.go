@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		accept  string
+		want    format
+		wantErr error
+	}{
+		{accept: "", want: formatMsgpack},
+		{accept: "*/*", want: formatMsgpack},
+		{accept: "application/*", want: formatMsgpack},
+		{accept: "application/json", want: formatJSON},
+		{accept: "application/cbor", want: formatCBOR},
+		{accept: "Application/JSON", want: formatJSON},
+		{accept: "application/json, application/cbor", want: formatJSON},
+		{accept: "application/cbor, application/json", want: formatCBOR},
+		{accept: "application/json;q=0.5, application/cbor", want: formatCBOR},
+		{accept: "application/json, */*", want: formatJSON},
+		{accept: "*/*, application/json", want: formatMsgpack},
+		{accept: "*/*;q=0.1, application/json;q=0.2", want: formatJSON},
+		{accept: "application/x-msgpack;q=0, */*", want: formatJSON},
+		{accept: "application/x-msgpack;q=0, application/json;q=0, */*", want: formatCBOR},
+		{accept: "application/x-msgpack;q=0, application/*", want: formatJSON},
+		{accept: "application/*;q=0, */*", wantErr: errNotAcceptable},
+		{accept: "application/json;q=0", wantErr: errNotAcceptable},
+		{accept: "*/*;q=0", wantErr: errNotAcceptable},
+		{accept: "text/html", wantErr: errNotAcceptable},
+		{accept: "text/html, application/json;q=0.1", want: formatJSON},
+		{accept: "application/json;q=invalid, application/cbor", want: formatCBOR},
+		{accept: "not a media type, application/json", want: formatJSON},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.accept, func(t *testing.T) {
+			got, err := negotiate(context.WithValue(context.Background(), acceptKey{}, tt.accept))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("format = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
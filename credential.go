@@ -0,0 +1,439 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/aaguid"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/api"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/audit"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/auth"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/enterprise"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/mds"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/policy"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/store"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/trust"
+)
+
+// Reactions to a signature counter which did not increase. See config.Policy.
+const (
+	cloneWarningLog    = "log"
+	cloneWarningFlag   = "flag"
+	cloneWarningReject = "reject"
+)
+
+var (
+	errClonedAuthenticator      = errors.New("credential is suspected to be cloned")
+	errBackupEligibilityChanged = errors.New("backup eligibility of the credential has changed")
+)
+
+// recordAssertion updates the signature counter, the flags and the last used time of the credential after a
+// successful assertion.
+//
+// A counter which did not increase is handled according to the clone warning policy, and errClonedAuthenticator
+// is returned when the policy rejects the login. The backup eligibility never changes for a credential, so a change
+// is an integrity error and errBackupEligibilityChanged is returned without updating the credential.
+func (hdl *Handler) recordAssertion(ctx context.Context, userID []byte, credentialID []byte, data protocol.AuthenticatorData) error {
+	var (
+		counter   = data.Counter
+		previous  uint32
+		regressed bool
+		rejected  bool
+		backedUp  = data.Flags.HasBackupState()
+		changed   bool
+	)
+
+	// NOTE: 同時にログインされてもカウンターを取りこぼさないように、比較と更新をストアの中で一度に行う
+	err := hdl.store.UpdateCredential(ctx, credentialID, func(cred *store.Credential) error {
+		flags := &cred.Credential.Flags
+
+		if flags.BackupEligible != data.Flags.HasBackupEligible() {
+			return errBackupEligibilityChanged
+		}
+
+		// NOTE: バックアップ状態は同期の設定などで変わりうるので毎回更新する
+		changed = flags.BackupState != backedUp
+
+		flags.UserPresent = data.Flags.HasUserPresent()
+		flags.UserVerified = data.Flags.HasUserVerified()
+		flags.BackupState = backedUp
+
+		authenticator := &cred.Credential.Authenticator
+
+		previous = authenticator.SignCount
+
+		// NOTE: カウンターを実装していない認証器は常に 0 を返すので、両方 0 なら比較しない
+		regressed = (counter != 0 || previous != 0) && counter <= previous
+
+		switch {
+		case !regressed:
+			authenticator.SignCount = counter
+		case hdl.cloneWarning != cloneWarningLog:
+			authenticator.CloneWarning = true
+		}
+
+		rejected = hdl.cloneWarning == cloneWarningReject && authenticator.CloneWarning
+
+		if !rejected {
+			cred.LastUsedAt = time.Now()
+		}
+
+		return nil
+	})
+	if errors.Is(err, errBackupEligibilityChanged) {
+		if err := hdl.audit.Record(ctx, audit.Event{
+			Type:         audit.TypeLoginRejected,
+			UserID:       userID,
+			CredentialID: credentialID,
+			Detail:       errBackupEligibilityChanged.Error(),
+		}); err != nil {
+			return fmt.Errorf("failed to record audit event. error: %w", err)
+		}
+
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update credential. error: %w", err)
+	}
+
+	if changed {
+		if err := hdl.audit.Record(ctx, audit.Event{
+			Type:         audit.TypeBackupStateChanged,
+			UserID:       userID,
+			CredentialID: credentialID,
+			Detail:       fmt.Sprintf("backup state: %t", backedUp),
+		}); err != nil {
+			return fmt.Errorf("failed to record audit event. error: %w", err)
+		}
+	}
+
+	if regressed {
+		if err := hdl.audit.Record(ctx, audit.Event{
+			Type:         audit.TypeCloneWarning,
+			UserID:       userID,
+			CredentialID: credentialID,
+			Detail:       fmt.Sprintf("signature counter %d did not exceed %d. policy: %s", counter, previous, hdl.cloneWarning),
+		}); err != nil {
+			return fmt.Errorf("failed to record audit event. error: %w", err)
+		}
+	}
+
+	if rejected {
+		if err := hdl.audit.Record(ctx, audit.Event{
+			Type:         audit.TypeLoginRejected,
+			UserID:       userID,
+			CredentialID: credentialID,
+			Detail:       errClonedAuthenticator.Error(),
+		}); err != nil {
+			return fmt.Errorf("failed to record audit event. error: %w", err)
+		}
+
+		return errClonedAuthenticator
+	}
+
+	return nil
+}
+
+var errLastCredential = errors.New("the last credential cannot be deleted as the user could not log in anymore")
+
+// ListCredentials implements api.Handler.
+func (hdl *Handler) ListCredentials(ctx context.Context) (api.ListCredentialsRes, error) {
+	ss, ok := auth.FromContext(ctx)
+	if !ok {
+		return &api.ListCredentialsUnauthorized{
+			Message: "login is required",
+		}, nil
+	}
+
+	creds, err := hdl.store.ListCredentials(ctx, ss.UserID)
+	if err != nil {
+		return &api.ListCredentialsInternalServerError{
+			Message: fmt.Sprintf("failed to list credentials. error: %s", err),
+		}, nil
+	}
+
+	res := make(api.ListCredentialsOKApplicationJSON, 0, len(creds))
+
+	for i := range creds {
+		res = append(res, hdl.credentialResponse(&creds[i]))
+	}
+
+	return &res, nil
+}
+
+// RenameCredential implements api.Handler.
+func (hdl *Handler) RenameCredential(ctx context.Context, req *api.RenameCredentialRequest, params api.RenameCredentialParams) (api.RenameCredentialRes, error) {
+	ss, ok := auth.FromContext(ctx)
+	if !ok {
+		return &api.RenameCredentialUnauthorized{
+			Message: "login is required",
+		}, nil
+	}
+
+	id, err := base64.RawURLEncoding.DecodeString(params.CredentialId)
+	if err != nil {
+		return &api.RenameCredentialNotFound{
+			Message: fmt.Sprintf("credential %s is not found", params.CredentialId),
+		}, nil
+	}
+
+	var renamed store.Credential
+
+	err = hdl.store.UpdateCredential(ctx, id, func(cred *store.Credential) error {
+		// NOTE: 他のユーザーのクレデンシャルの存在を明かさないように見つからなかったことにする
+		if !bytes.Equal(cred.UserID, ss.UserID) {
+			return store.ErrNotFound
+		}
+
+		cred.Nickname = strings.TrimSpace(req.Nickname)
+
+		renamed = *cred
+
+		return nil
+	})
+	if errors.Is(err, store.ErrNotFound) {
+		return &api.RenameCredentialNotFound{
+			Message: fmt.Sprintf("credential %s is not found", params.CredentialId),
+		}, nil
+	}
+	if err != nil {
+		return &api.RenameCredentialInternalServerError{
+			Message: fmt.Sprintf("failed to update credential. error: %s", err),
+		}, nil
+	}
+
+	res := hdl.credentialResponse(&renamed)
+
+	return &res, nil
+}
+
+// DeleteCredential implements api.Handler.
+func (hdl *Handler) DeleteCredential(ctx context.Context, params api.DeleteCredentialParams) (api.DeleteCredentialRes, error) {
+	ss, ok := auth.FromContext(ctx)
+	if !ok {
+		return &api.DeleteCredentialUnauthorized{
+			Message: "login is required",
+		}, nil
+	}
+
+	id, err := base64.RawURLEncoding.DecodeString(params.CredentialId)
+	if err != nil {
+		return &api.DeleteCredentialNotFound{
+			Message: fmt.Sprintf("credential %s is not found", params.CredentialId),
+		}, nil
+	}
+
+	// NOTE: 同時に削除されても最後のクレデンシャルが残るように、数の確認と削除をストアの中で一度に行う
+	err = hdl.store.DeleteCredential(ctx, id, func(cred *store.Credential, others []store.Credential) error {
+		if !bytes.Equal(cred.UserID, ss.UserID) {
+			return store.ErrNotFound
+		}
+
+		// NOTE: パスキー以外の回復手段はないので、最後のクレデンシャルを消すとログインできなくなる
+		if len(others) == 0 {
+			return errLastCredential
+		}
+
+		// NOTE: 最後の鍵のコピーを消すと保管庫を復号できなくなるので、先に別のパスキーで包み直すか保管庫を破棄してもらう
+		if cred.VaultKey != nil && !slices.ContainsFunc(others, func(other store.Credential) bool { return other.VaultKey != nil }) {
+			return errLastVaultKey
+		}
+
+		return nil
+	})
+	if errors.Is(err, store.ErrNotFound) {
+		return &api.DeleteCredentialNotFound{
+			Message: fmt.Sprintf("credential %s is not found", params.CredentialId),
+		}, nil
+	}
+	if errors.Is(err, errLastCredential) {
+		return &api.DeleteCredentialConflict{
+			Code:    api.NewOptString("last_credential"),
+			Message: err.Error(),
+		}, nil
+	}
+	if errors.Is(err, errLastVaultKey) {
+		return &api.DeleteCredentialConflict{
+			Code:    api.NewOptString("last_vault_key"),
+			Message: err.Error(),
+		}, nil
+	}
+	if err != nil {
+		return &api.DeleteCredentialInternalServerError{
+			Message: fmt.Sprintf("failed to delete credential. error: %s", err),
+		}, nil
+	}
+
+	// NOTE: 削除したクレデンシャルでログインしたセッションは使えなくする
+	hdl.auth.RevokeCredential(id)
+
+	if err := hdl.audit.Record(ctx, audit.Event{
+		Type:         audit.TypeCredentialDeleted,
+		UserID:       ss.UserID,
+		CredentialID: id,
+	}); err != nil {
+		return &api.DeleteCredentialInternalServerError{
+			Message: fmt.Sprintf("failed to record audit event. error: %s", err),
+		}, nil
+	}
+
+	return &api.DeleteCredentialNoContent{}, nil
+}
+
+// credentialResponse converts a stored credential to the response, labelled with the authenticator model.
+func (hdl *Handler) credentialResponse(cred *store.Credential) api.Credential {
+	res := api.Credential{
+		ID:             base64.RawURLEncoding.EncodeToString(cred.Credential.ID),
+		CreatedAt:      cred.CreatedAt,
+		Transports:     make([]string, 0, len(cred.Credential.Transport)),
+		Aaguid:         aaguid.Format(cred.Credential.Authenticator.AAGUID),
+		SignCount:      int64(cred.Credential.Authenticator.SignCount),
+		CloneWarning:   cred.Credential.Authenticator.CloneWarning,
+		BackupEligible: cred.Credential.Flags.BackupEligible,
+		BackupState:    cred.Credential.Flags.BackupState,
+	}
+
+	for _, transport := range cred.Credential.Transport {
+		res.Transports = append(res.Transports, string(transport))
+	}
+
+	if cred.Nickname != "" {
+		res.Nickname = api.NewOptString(cred.Nickname)
+	}
+
+	if attachment := cred.Credential.Authenticator.Attachment; attachment != "" {
+		res.Attachment = api.NewOptString(string(attachment))
+	}
+
+	if !cred.LastUsedAt.IsZero() {
+		res.LastUsedAt = api.NewOptDateTime(cred.LastUsedAt)
+	}
+
+	if cred.TrustAnchor != "" {
+		res.TrustAnchor = api.NewOptString(cred.TrustAnchor)
+	}
+
+	if ext := cred.Extensions; ext.Discoverable != nil {
+		res.Discoverable = api.NewOptBool(*ext.Discoverable)
+	}
+
+	if ext := cred.Extensions; ext.CredProtect != "" {
+		res.CredProtect = api.NewOptString(ext.CredProtect)
+	}
+
+	if ext := cred.Extensions; ext.MinPinLength > 0 {
+		res.MinPinLength = api.NewOptInt(ext.MinPinLength)
+	}
+
+	if ext := cred.Extensions; ext.LargeBlobSupported != nil {
+		res.LargeBlobSupported = api.NewOptBool(*ext.LargeBlobSupported)
+	}
+
+	res.VaultKey = api.NewOptBool(cred.VaultKey != nil)
+
+	if ext := cred.Extensions; ext.PRF != nil {
+		res.Prf = api.NewOptBool(*ext.PRF)
+	}
+
+	if cred.DeviceSerial != "" {
+		res.DeviceSerial = api.NewOptString(cred.DeviceSerial)
+		res.DeviceFingerprint = api.NewOptString(cred.DeviceFingerprint)
+	}
+
+	if model, ok := hdl.aaguids.Lookup(cred.Credential.Authenticator.AAGUID); ok {
+		res.AuthenticatorName = api.NewOptString(model.Name)
+
+		if model.IconLight != "" {
+			res.IconLight = api.NewOptString(model.IconLight)
+		}
+
+		if model.IconDark != "" {
+			res.IconDark = api.NewOptString(model.IconDark)
+		}
+	}
+
+	return res
+}
+
+// describeAuthenticator describes the authenticator model with the FIDO Metadata Service, the trust anchor which
+// validated its attestation and the device identified by enterprise attestation for the audit trail.
+func (hdl *Handler) describeAuthenticator(cred *store.Credential) string {
+	var details []string
+
+	if hdl.metadata != nil {
+		if entry, ok := hdl.metadata.Lookup(cred.Credential.Authenticator.AAGUID); ok {
+			details = append(details, fmt.Sprintf("authenticator: %s, status: %s", entry.MetadataStatement.Description, mds.Status(entry)))
+		} else {
+			details = append(details, "authenticator not found in metadata")
+		}
+	}
+
+	if cred.TrustAnchor != "" {
+		details = append(details, fmt.Sprintf("trust anchor: %s", cred.TrustAnchor))
+	}
+
+	if cred.DeviceSerial != "" {
+		details = append(details, fmt.Sprintf("device serial: %s, fingerprint: %s", cred.DeviceSerial, cred.DeviceFingerprint))
+	}
+
+	return strings.Join(details, ", ")
+}
+
+// evaluateAttestation evaluates the attestation policy against the new credential. The trust anchor which validated
+// its attestation and the device identified by enterprise attestation are recorded in cred. A refusal is a
+// *policy.Violation.
+func (hdl *Handler) evaluateAttestation(ctx context.Context, cred *store.Credential, att protocol.AttestationObject) error {
+	sub := policy.Subject{
+		Format: cred.Credential.AttestationType,
+		AAGUID: aaguid.Format(cred.Credential.Authenticator.AAGUID),
+	}
+
+	if hdl.metadata != nil {
+		if entry, ok := hdl.metadata.Lookup(cred.Credential.Authenticator.AAGUID); ok {
+			sub.Metadata = &entry
+		}
+	}
+
+	if hdl.trustStore != nil {
+		anchor, err := hdl.trustStore.Verify(att.Format, att.AttStatement, time.Now())
+		switch {
+		case err == nil:
+			sub.TrustAnchor = anchor.Name
+		case errors.Is(err, trust.ErrNoChain):
+			sub.TrustError = err
+		default:
+			sub.TrustError = err
+
+			slog.WarnContext(ctx, fmt.Sprintf("failed to validate attestation of %s. error: %s", sub.Format, err))
+		}
+	}
+
+	// NOTE: 一意に識別できる証明書が返るのは enterprise attestation を要求し、認証器がそれを認めた場合だけ
+	if hdl.webAuthn.Config.AttestationPreference == protocol.PreferEnterpriseAttestation && enterprise.Granted(cred.AttestationObject) {
+		if dev, err := enterprise.Identify(att.AttStatement); err == nil {
+			sub.Device = dev
+		}
+	}
+
+	if err := hdl.attestation.Evaluate(sub); err != nil {
+		return err
+	}
+
+	cred.TrustAnchor = sub.TrustAnchor
+
+	if sub.Device != nil {
+		cred.DeviceSerial = sub.Device.Serial
+		cred.DeviceFingerprint = sub.Device.Fingerprint
+	}
+
+	return nil
+}
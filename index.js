@@ -7,17 +7,32 @@ const bufferEncode = (value) =>
         .replace(/\//g, "_")
         .replace(/=/g, "");
 
+// NOTE: msgpack-lite は既定で ArrayBuffer を拡張型にするので、サーバーが読める bin 形式にする
+const binaryCodec = msgpack.createCodec({ binarraybuffer: true });
+
 const attestation = async () => {
     event.preventDefault();
 
-    const result = await fetch("http://localhost:8080/attestation", {
+    const query = new URLSearchParams(new FormData(event.target));
+
+    const result = await fetch(`http://localhost:8080/attestation?${query}`, {
         method: "GET",
         credentials: "include",
         headers: {
-            "Content-Type": "application/x-msgpack"
+            "Accept": "application/x-msgpack"
         },
     })
 
+    if (result.status === 409) {
+        alert("The name is already registered. Login as the user to add a passkey")
+        return
+    }
+
+    if (result.status !== 200) {
+        alert("Failed to initialize attestation")
+        return
+    }
+
     const buf = await result.arrayBuffer();
 
     const publicKey = msgpack.decode(new Uint8Array(buf.slice()));
@@ -30,29 +45,38 @@ const attestation = async () => {
         publicKey: publicKey,
     })
 
-    // NOTE: msgpack.encode を使いたかったけどサーバーにうまくリクエストできなかったので挫折 ... 
-
-    // NOTE: 2023/12/30 https://developer.mozilla.org/en-US/docs/Web/API/PublicKeyCredential/toJSON
-    // まだ credential.toJSON() が実装されていないため、自前で実装する
-
+    // NOTE: MessagePack ならバイナリのフィールドを base64url にせずそのまま送れる
     const response = await fetch("http://localhost:8080/attestation", {
         method: "POST",
         credentials: "include",
         headers: {
-            "Content-Type": "text/plain"
+            "Content-Type": "application/x-msgpack"
         },
-        body: JSON.stringify({
+        body: msgpack.encode({
             id: credential.id,
-            rawId: bufferEncode(credential.rawId),
+            rawId: credential.rawId,
             type: credential.type,
             clientExtensionResults: credential.getClientExtensionResults(),
+            authenticatorAttachment: credential.authenticatorAttachment,
             response: {
-                attestationObject: bufferEncode(credential.response.attestationObject),
-                clientDataJSON: bufferEncode(credential.response.clientDataJSON),
+                attestationObject: credential.response.attestationObject,
+                clientDataJSON: credential.response.clientDataJSON,
+                transports: credential.response.getTransports ? credential.response.getTransports() : [],
             },
-        }),
+        }, { codec: binaryCodec }),
     });
 
+    if (response.status === 409) {
+        alert("This passkey is already registered")
+        return
+    }
+
+    if (response.status === 403) {
+        const error = await response.json()
+        alert(`This authenticator is not allowed: ${error.message}`)
+        return
+    }
+
     if (response.status !== 200) {
         alert("Failed to attestation")
     }
@@ -62,37 +86,379 @@ document
     .getElementById("attestation")
     .addEventListener("submit", attestation);
 
-const attestationJSON = async () => {
-    event.preventDefault();
+// NOTE: conditional mediation のリクエストを中断するためのコントローラー
+let conditionalController;
+
+const conditional = async () => {
+    if (!window.PublicKeyCredential || !PublicKeyCredential.isConditionalMediationAvailable) {
+        return
+    }
+
+    if (!(await PublicKeyCredential.isConditionalMediationAvailable())) {
+        return
+    }
+
+    const controller = new AbortController();
+
+    conditionalController = controller;
 
-    const result = await fetch("http://localhost:8080/attestation/json", {
+    const result = await fetch("http://localhost:8080/assertion?mediation=conditional", {
         method: "GET",
         credentials: "include",
         headers: {
-            "Content-Type": "text/plain"
+            "Accept": "application/x-msgpack"
         },
     })
 
-    const publicKey = await result.json();
+    if (result.status !== 200) {
+        return
+    }
+
+    const buf = await result.arrayBuffer();
+
+    const publicKey = msgpack.decode(new Uint8Array(buf.slice()));
+
+    // NOTE: チャレンジの有効期限が切れたら取り直す
+    const timer = setTimeout(() => controller.abort("expired"), publicKey.timeout);
+
+    let credential;
+
+    try {
+        credential = await navigator.credentials.get({
+            mediation: "conditional",
+            publicKey: publicKey,
+            signal: controller.signal,
+        })
+    } catch (e) {
+        if (controller.signal.reason === "expired") {
+            conditional();
+        }
+        return
+    } finally {
+        clearTimeout(timer);
+    }
+
+    const response = await fetch("http://localhost:8080/assertion?mediation=conditional", {
+        method: "POST",
+        credentials: "include",
+        headers: {
+            "Content-Type": "application/json"
+        },
+        body: JSON.stringify({
+            id: credential.id,
+            rawId: bufferEncode(credential.rawId),
+            type: credential.type,
+            response: {
+                authenticatorData: bufferEncode(credential.response.authenticatorData),
+                clientDataJSON: bufferEncode(credential.response.clientDataJSON),
+                signature: bufferEncode(credential.response.signature),
+                userHandle: credential.response.userHandle ? bufferEncode(credential.response.userHandle) : undefined,
+            },
+            clientExtensionResults: assertionExtensionResults(credential),
+        }),
+    });
+
+    if (response.status !== 200) {
+        alert("Failed to assertion")
+        return
+    }
+
+    console.info(await response.json());
+};
+
+// prfSecret is the prf output of the last login. It is key material for client-side encryption and stays in the browser.
+let prfSecret;
+
+// NOTE: largeBlob.blob は ArrayBuffer なので JSON で送れるように base64url にする
+// NOTE: prf の結果は鍵の材料なのでサーバーには送らず、得られたかどうかだけを伝える
+const assertionExtensionResults = (credential) => {
+    const results = credential.getClientExtensionResults();
+
+    if (results.largeBlob && results.largeBlob.blob) {
+        results.largeBlob = { ...results.largeBlob, blob: bufferEncode(results.largeBlob.blob) };
+    }
+
+    if (results.prf) {
+        prfSecret = results.prf.results ? results.prf.results.first : undefined;
+
+        results.prf = { enabled: prfSecret !== undefined };
+    }
+
+    return results;
+};
+
+const assertion = async () => {
+    event.preventDefault();
 
-    publicKey.challenge = bufferDecode(publicKey.challenge);
+    // NOTE: 明示的にログインする場合は待機している conditional mediation を中断する
+    if (conditionalController) {
+        conditionalController.abort("explicit");
 
-    publicKey.user.id = bufferDecode(publicKey.user.id);
+        conditionalController = undefined;
 
-    if (publicKey.excludeCredentials) {
-        publicKey.excludeCredentials = publicKey.excludeCredentials.forEach((credential) => {
-            credential.id = bufferDecode(credential.id);
+        await fetch("http://localhost:8080/assertion?mediation=conditional", {
+            method: "DELETE",
+            credentials: "include",
         });
     }
 
-    const credential = await navigator.credentials.create({
+    // NOTE: name が空なら discoverable credential でログインする
+    const query = new URLSearchParams(
+        [...new FormData(event.target)].filter(([, value]) => value !== "")
+    );
+
+    const result = await fetch(`http://localhost:8080/assertion?${query}`, {
+        method: "GET",
+        credentials: "include",
+        headers: {
+            "Accept": "application/x-msgpack"
+        },
+    })
+
+    if (result.status === 404) {
+        alert("User not found")
+        return
+    }
+
+    if (result.status !== 200) {
+        alert("Failed to initialize assertion")
+        return
+    }
+
+    const buf = await result.arrayBuffer();
+
+    const publicKey = msgpack.decode(new Uint8Array(buf.slice()));
+
+    console.info(publicKey);
+
+    const credential = await navigator.credentials.get({
         publicKey: publicKey,
     })
 
-    console.log(credential);
+    const response = await fetch("http://localhost:8080/assertion", {
+        method: "POST",
+        credentials: "include",
+        headers: {
+            "Content-Type": "application/json"
+        },
+        body: JSON.stringify({
+            id: credential.id,
+            rawId: bufferEncode(credential.rawId),
+            type: credential.type,
+            response: {
+                authenticatorData: bufferEncode(credential.response.authenticatorData),
+                clientDataJSON: bufferEncode(credential.response.clientDataJSON),
+                signature: bufferEncode(credential.response.signature),
+                userHandle: credential.response.userHandle ? bufferEncode(credential.response.userHandle) : undefined,
+            },
+            clientExtensionResults: assertionExtensionResults(credential),
+        }),
+    });
+
+    if (response.status !== 200) {
+        alert("Failed to assertion")
+        return
+    }
+
+    console.info(await response.json());
+};
+
+document
+    .getElementById("assertion")
+    .addEventListener("submit", assertion);
+
+document
+    .getElementById("discoverable")
+    .addEventListener("submit", assertion);
+
+const me = async () => {
+    event.preventDefault();
+
+    const response = await fetch("http://localhost:8080/me", {
+        method: "GET",
+        credentials: "include",
+    });
+
+    if (response.status !== 200) {
+        alert("Not logged in")
+        return
+    }
+
+    console.info(await response.json());
+
+    const backup = await fetch("http://localhost:8080/backup-status", {
+        method: "GET",
+        credentials: "include",
+    });
+
+    // NOTE: 端末に紐づいたパスキーしかなければ、端末を失くす前に同期できるパスキーの追加を促す
+    if (backup.status === 200 && (await backup.json()).deviceBoundOnly) {
+        alert("Your passkeys are bound to their devices. Add a passkey which can be synced as a backup")
+    }
+};
+
+document
+    .getElementById("me")
+    .addEventListener("submit", me);
+
+// vaultKey is the unwrapped data encryption key of the vault. It is kept to wrap it for a passkey added later.
+let vaultKey;
+let vaultKeyId;
+
+// NOTE: prf の出力から保管庫の鍵を包む鍵を導出する。prf の出力も保管庫の鍵もサーバーには送らない
+const wrappingKey = async () => {
+    const material = await crypto.subtle.importKey("raw", prfSecret, "HKDF", false, ["deriveKey"]);
+
+    return crypto.subtle.deriveKey(
+        { name: "HKDF", hash: "SHA-256", salt: new Uint8Array(), info: new TextEncoder().encode("vault key wrapping") },
+        material,
+        { name: "AES-KW", length: 256 },
+        false,
+        ["wrapKey", "unwrapKey"],
+    );
+};
+
+const vault = async () => {
+    event.preventDefault();
+
+    if (!prfSecret) {
+        alert("Login by name with a passkey supporting prf first")
+        return
+    }
+
+    const kek = await wrappingKey();
+
+    const response = await fetch("http://localhost:8080/vault/key", {
+        method: "GET",
+        credentials: "include",
+    });
+
+    if (response.status === 200) {
+        const body = await response.json();
+
+        vaultKey = await crypto.subtle.unwrapKey(
+            "raw",
+            bufferDecode(body.wrappedKey.replace(/-/g, "+").replace(/_/g, "/")),
+            kek,
+            "AES-KW",
+            "AES-GCM",
+            true,
+            ["encrypt", "decrypt"],
+        );
+        vaultKeyId = body.keyId;
+
+        console.info(`vault key ${vaultKeyId} unwrapped`);
+        return
+    }
+
+    if (response.status !== 404) {
+        alert("Failed to get vault key")
+        return
+    }
+
+    // NOTE: 別のパスキーで開いた鍵があればこのパスキー用に包み直し、無ければ新しい鍵を作る
+    if (!vaultKey) {
+        vaultKey = await crypto.subtle.generateKey({ name: "AES-GCM", length: 256 }, true, ["encrypt", "decrypt"]);
+        vaultKeyId = crypto.randomUUID();
+    }
+
+    const wrapped = await crypto.subtle.wrapKey("raw", vaultKey, kek, "AES-KW");
+
+    const put = await fetch("http://localhost:8080/vault/key", {
+        method: "PUT",
+        credentials: "include",
+        headers: {
+            "Content-Type": "application/json"
+        },
+        body: JSON.stringify({
+            keyId: vaultKeyId,
+            wrappedKey: bufferEncode(wrapped),
+        }),
+    });
+
+    if (put.status !== 200) {
+        alert(`Failed to wrap vault key: ${(await put.json()).message}`)
+        return
+    }
+
+    console.info(`vault key ${vaultKeyId} wrapped`);
+};
+
+document
+    .getElementById("vault")
+    .addEventListener("submit", vault);
+
+const credentials = async () => {
+    event.preventDefault();
+
+    const response = await fetch("http://localhost:8080/credentials", {
+        method: "GET",
+        credentials: "include",
+    });
+
+    if (response.status !== 200) {
+        alert("Not logged in")
+        return
+    }
+
+    console.info(await response.json());
+};
+
+document
+    .getElementById("credentials")
+    .addEventListener("submit", credentials);
+
+const logout = async () => {
+    event.preventDefault();
+
+    await fetch("http://localhost:8080/logout", {
+        method: "POST",
+        credentials: "include",
+    });
+};
+
+document
+    .getElementById("logout")
+    .addEventListener("submit", logout);
+
+conditional();
+
+// NOTE: 同じ /attestation を JSON で使う。オプションの読み込みと結果の書き出しはブラウザの JSON 対応に任せる
+const attestationJSON = async () => {
+    event.preventDefault();
+
+    const query = new URLSearchParams(new FormData(event.target));
+
+    const result = await fetch(`http://localhost:8080/attestation?${query}`, {
+        method: "GET",
+        credentials: "include",
+        headers: {
+            "Accept": "application/json"
+        },
+    })
+
+    if (result.status !== 200) {
+        alert("Failed to initialize attestation")
+        return
+    }
+
+    const credential = await navigator.credentials.create({
+        publicKey: PublicKeyCredential.parseCreationOptionsFromJSON(await result.json()),
+    })
+
+    const response = await fetch("http://localhost:8080/attestation", {
+        method: "POST",
+        credentials: "include",
+        headers: {
+            "Content-Type": "application/json"
+        },
+        body: JSON.stringify(credential.toJSON()),
+    });
 
-    // NOTE: このあと登録完了を実施
-}
+    if (response.status !== 200) {
+        alert("Failed to attestation")
+    }
+};
 
 document
     .getElementById("json")
@@ -0,0 +1,138 @@
+// Package aaguid resolves AAGUIDs to the names and icons of authenticator models.
+//
+// The dataset uses the format of the community list of passkey providers
+// (https://github.com/passkeydeveloper/passkey-authenticator-aaguids): a JSON object keyed by AAGUID whose values
+// carry "name", "icon_light" and "icon_dark". A small dataset is bundled and a full copy of the list can be loaded
+// from a local file.
+//
+// The bundled dataset only has names: the icons are logos of their providers and make the list several megabytes,
+// so they are not shipped. Icons come from a loaded copy of the list or from the FIDO Metadata Service.
+package aaguid
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/go-webauthn/webauthn/metadata"
+)
+
+//go:embed aaguid.json
+var bundled []byte
+
+// Entry is an authenticator model. Icons are data URLs.
+type Entry struct {
+	Name      string `json:"name"`
+	IconLight string `json:"icon_light,omitempty"`
+	IconDark  string `json:"icon_dark,omitempty"`
+}
+
+// Registry maps AAGUIDs to authenticator models.
+type Registry struct {
+	mu      sync.RWMutex
+	entries map[string]Entry
+}
+
+// New returns a registry holding the bundled dataset.
+func New() (*Registry, error) {
+	reg := &Registry{
+		entries: map[string]Entry{},
+	}
+
+	if err := reg.Load(bundled); err != nil {
+		return nil, fmt.Errorf("failed to load bundled dataset. error: %w", err)
+	}
+
+	return reg, nil
+}
+
+// Load adds the entries of a dataset in the community format, replacing entries with the same AAGUID.
+func (reg *Registry) Load(buf []byte) error {
+	var entries map[string]Entry
+
+	if err := json.Unmarshal(buf, &entries); err != nil {
+		return fmt.Errorf("failed to unmarshal dataset. error: %w", err)
+	}
+
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	for key, entry := range entries {
+		reg.entries[strings.ToLower(key)] = entry
+	}
+
+	return nil
+}
+
+// LoadFile adds the entries of the dataset at path.
+func (reg *Registry) LoadFile(path string) error {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s. error: %w", path, err)
+	}
+
+	if err := reg.Load(buf); err != nil {
+		return fmt.Errorf("failed to load %s. error: %w", path, err)
+	}
+
+	return nil
+}
+
+// AddMetadata adds the authenticators described by FIDO Metadata Service entries. The community dataset is
+// preferred, so entries already known keep their names and only get the icon if they have none.
+func (reg *Registry) AddMetadata(entries []metadata.MetadataBLOBPayloadEntry) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	for _, entry := range entries {
+		key := strings.ToLower(entry.AaGUID)
+		if key == "" || entry.MetadataStatement.Description == "" {
+			continue
+		}
+
+		if known, ok := reg.entries[key]; ok {
+			// NOTE: 同梱のデータセットにはアイコンがないので MDS のアイコンで補う
+			if known.IconLight == "" && known.IconDark == "" && entry.MetadataStatement.Icon != "" {
+				known.IconLight = entry.MetadataStatement.Icon
+				known.IconDark = entry.MetadataStatement.Icon
+
+				reg.entries[key] = known
+			}
+
+			continue
+		}
+
+		// NOTE: MDS のアイコンは一種類しかないのでライトとダークの両方に使う
+		reg.entries[key] = Entry{
+			Name:      entry.MetadataStatement.Description,
+			IconLight: entry.MetadataStatement.Icon,
+			IconDark:  entry.MetadataStatement.Icon,
+		}
+	}
+}
+
+// Lookup returns the authenticator model of the AAGUID.
+func (reg *Registry) Lookup(aaguid []byte) (Entry, bool) {
+	if len(aaguid) != 16 {
+		return Entry{}, false
+	}
+
+	reg.mu.RLock()
+	defer reg.mu.RUnlock()
+
+	entry, ok := reg.entries[Format(aaguid)]
+
+	return entry, ok
+}
+
+// Format formats the AAGUID in the lower case UUID form.
+func Format(aaguid []byte) string {
+	if len(aaguid) != 16 {
+		return ""
+	}
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", aaguid[0:4], aaguid[4:6], aaguid[6:8], aaguid[8:10], aaguid[10:16])
+}
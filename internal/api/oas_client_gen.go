@@ -23,38 +23,108 @@ import (
 
 // Invoker invokes operations described by OpenAPI v3 specification.
 type Invoker interface {
+	// AbortAssertion invokes abortAssertion operation.
+	//
+	// Abort Assertion.
+	//
+	// DELETE /assertion
+	AbortAssertion(ctx context.Context, params AbortAssertionParams) (*AbortAssertionNoContent, error)
+	// DeleteCredential invokes deleteCredential operation.
+	//
+	// Delete a credential of the user of the login session. The last credential is kept as there is no
+	// other way to log in, and so is the last credential holding the vault key unless the vault is
+	// discarded.
+	//
+	// DELETE /credentials/{credentialId}
+	DeleteCredential(ctx context.Context, params DeleteCredentialParams) (DeleteCredentialRes, error)
+	// DiscardVault invokes discardVault operation.
+	//
+	// Discard the vault key wrapped for every credential of the user of the login session. Data
+	// encrypted with it cannot be decrypted anymore.
+	//
+	// DELETE /vault
+	DiscardVault(ctx context.Context) (DiscardVaultRes, error)
 	// FinalizeAssertion invokes finalizeAssertion operation.
 	//
 	// Finalize Assertion.
 	//
 	// POST /assertion
-	FinalizeAssertion(ctx context.Context, request OptFinalizeAssertionRequest) (FinalizeAssertionRes, error)
+	FinalizeAssertion(ctx context.Context, request FinalizeAssertionReq, params FinalizeAssertionParams) (FinalizeAssertionRes, error)
 	// FinalizeAttestation invokes finalizeAttestation operation.
 	//
 	// Finalize Attestation.
 	//
 	// POST /attestation
 	FinalizeAttestation(ctx context.Context, request FinalizeAttestationReq, params FinalizeAttestationParams) (FinalizeAttestationRes, error)
+	// GetBackupStatus invokes getBackupStatus operation.
+	//
+	// Report whether the passkeys of the user of the login session are backed up or only device-bound.
+	//
+	// GET /backup-status
+	GetBackupStatus(ctx context.Context) (GetBackupStatusRes, error)
+	// GetMe invokes getMe operation.
+	//
+	// Get the user and the credential of the login session.
+	//
+	// GET /me
+	GetMe(ctx context.Context) (GetMeRes, error)
+	// GetVaultKey invokes getVaultKey operation.
+	//
+	// Get the vault key wrapped for the credential the login session was asserted with. The client
+	// unwraps it with the prf output of that login.
+	//
+	// GET /vault/key
+	GetVaultKey(ctx context.Context) (GetVaultKeyRes, error)
 	// InitializeAssertion invokes initializeAssertion operation.
 	//
 	// Initialize Assertion.
 	//
 	// GET /assertion
-	InitializeAssertion(ctx context.Context) (InitializeAssertionRes, error)
+	InitializeAssertion(ctx context.Context, params InitializeAssertionParams) (InitializeAssertionRes, error)
 	// InitializeAttestation invokes initializeAttestation operation.
 	//
 	// Initialize Attestation.
 	//
 	// GET /attestation
-	InitializeAttestation(ctx context.Context) (InitializeAttestationRes, error)
-	// InitializeAttestationJSON invokes initializeAttestationJSON operation.
+	InitializeAttestation(ctx context.Context, params InitializeAttestationParams) (InitializeAttestationRes, error)
+	// InspectAttestation invokes inspectAttestation operation.
+	//
+	// Decode the attestation object and the client data kept with a credential. The login user must be
+	// an admin.
+	//
+	// GET /admin/credentials/{credentialId}/attestation
+	InspectAttestation(ctx context.Context, params InspectAttestationParams) (InspectAttestationRes, error)
+	// ListAuditEvents invokes listAuditEvents operation.
+	//
+	// List the audit events of the user of the login session, newest first.
+	//
+	// GET /audit
+	ListAuditEvents(ctx context.Context) (ListAuditEventsRes, error)
+	// ListCredentials invokes listCredentials operation.
 	//
-	// Initialize Attestation JSON.
+	// List the credentials of the user of the login session in creation order.
 	//
-	// Deprecated: schema marks this operation as deprecated.
+	// GET /credentials
+	ListCredentials(ctx context.Context) (ListCredentialsRes, error)
+	// Logout invokes logout operation.
 	//
-	// GET /attestation/json
-	InitializeAttestationJSON(ctx context.Context) (InitializeAttestationJSONRes, error)
+	// Logout.
+	//
+	// POST /logout
+	Logout(ctx context.Context) (*LogoutNoContent, error)
+	// PutVaultKey invokes putVaultKey operation.
+	//
+	// Store the vault key wrapped for the credential the login session was asserted with. Every
+	// credential of the user must wrap the same key.
+	//
+	// PUT /vault/key
+	PutVaultKey(ctx context.Context, request *PutVaultKeyRequest) (PutVaultKeyRes, error)
+	// RenameCredential invokes renameCredential operation.
+	//
+	// Rename a credential of the user of the login session.
+	//
+	// PATCH /credentials/{credentialId}
+	RenameCredential(ctx context.Context, request *RenameCredentialRequest, params RenameCredentialParams) (RenameCredentialRes, error)
 }
 
 // Client implements OAS client.
@@ -105,20 +175,20 @@ func (c *Client) requestURL(ctx context.Context) *url.URL {
 	return u
 }
 
-// FinalizeAssertion invokes finalizeAssertion operation.
+// AbortAssertion invokes abortAssertion operation.
 //
-// Finalize Assertion.
+// Abort Assertion.
 //
-// POST /assertion
-func (c *Client) FinalizeAssertion(ctx context.Context, request OptFinalizeAssertionRequest) (FinalizeAssertionRes, error) {
-	res, err := c.sendFinalizeAssertion(ctx, request)
+// DELETE /assertion
+func (c *Client) AbortAssertion(ctx context.Context, params AbortAssertionParams) (*AbortAssertionNoContent, error) {
+	res, err := c.sendAbortAssertion(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendFinalizeAssertion(ctx context.Context, request OptFinalizeAssertionRequest) (res FinalizeAssertionRes, err error) {
+func (c *Client) sendAbortAssertion(ctx context.Context, params AbortAssertionParams) (res *AbortAssertionNoContent, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("finalizeAssertion"),
-		semconv.HTTPMethodKey.String("POST"),
+		otelogen.OperationID("abortAssertion"),
+		semconv.HTTPMethodKey.String("DELETE"),
 		semconv.HTTPRouteKey.String("/assertion"),
 	}
 
@@ -134,7 +204,7 @@ func (c *Client) sendFinalizeAssertion(ctx context.Context, request OptFinalizeA
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "FinalizeAssertion",
+	ctx, span := c.cfg.Tracer.Start(ctx, "AbortAssertion",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -155,13 +225,66 @@ func (c *Client) sendFinalizeAssertion(ctx context.Context, request OptFinalizeA
 	pathParts[0] = "/assertion"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "mediation" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "mediation",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Mediation.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeFinalizeAssertionRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
+
+	stage = "EncodeCookieParams"
+	cookie := uri.NewCookieEncoder(r)
+	{
+		// Encode "session" parameter.
+		cfg := uri.CookieParameterEncodingConfig{
+			Name:    "session",
+			Explode: true,
+		}
+
+		if err := cookie.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Session.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode cookie")
+		}
+	}
+	{
+		// Encode "conditional_session" parameter.
+		cfg := uri.CookieParameterEncodingConfig{
+			Name:    "conditional_session",
+			Explode: true,
+		}
+
+		if err := cookie.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ConditionalSession.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode cookie")
+		}
 	}
 
 	stage = "SendRequest"
@@ -172,7 +295,7 @@ func (c *Client) sendFinalizeAssertion(ctx context.Context, request OptFinalizeA
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeFinalizeAssertionResponse(resp)
+	result, err := decodeAbortAssertionResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -180,21 +303,23 @@ func (c *Client) sendFinalizeAssertion(ctx context.Context, request OptFinalizeA
 	return result, nil
 }
 
-// FinalizeAttestation invokes finalizeAttestation operation.
+// DeleteCredential invokes deleteCredential operation.
 //
-// Finalize Attestation.
+// Delete a credential of the user of the login session. The last credential is kept as there is no
+// other way to log in, and so is the last credential holding the vault key unless the vault is
+// discarded.
 //
-// POST /attestation
-func (c *Client) FinalizeAttestation(ctx context.Context, request FinalizeAttestationReq, params FinalizeAttestationParams) (FinalizeAttestationRes, error) {
-	res, err := c.sendFinalizeAttestation(ctx, request, params)
+// DELETE /credentials/{credentialId}
+func (c *Client) DeleteCredential(ctx context.Context, params DeleteCredentialParams) (DeleteCredentialRes, error) {
+	res, err := c.sendDeleteCredential(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendFinalizeAttestation(ctx context.Context, request FinalizeAttestationReq, params FinalizeAttestationParams) (res FinalizeAttestationRes, err error) {
+func (c *Client) sendDeleteCredential(ctx context.Context, params DeleteCredentialParams) (res DeleteCredentialRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("finalizeAttestation"),
-		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/attestation"),
+		otelogen.OperationID("deleteCredential"),
+		semconv.HTTPMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/credentials/{credentialId}"),
 	}
 
 	// Run stopwatch.
@@ -209,7 +334,7 @@ func (c *Client) sendFinalizeAttestation(ctx context.Context, request FinalizeAt
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "FinalizeAttestation",
+	ctx, span := c.cfg.Tracer.Start(ctx, "DeleteCredential",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -226,33 +351,105 @@ func (c *Client) sendFinalizeAttestation(ctx context.Context, request FinalizeAt
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/attestation"
+	var pathParts [2]string
+	pathParts[0] = "/credentials/"
+	{
+		// Encode "credentialId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "credentialId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.CredentialId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeFinalizeAttestationRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
 	}
+	defer resp.Body.Close()
 
-	stage = "EncodeCookieParams"
-	cookie := uri.NewCookieEncoder(r)
-	{
-		// Encode "session" parameter.
-		cfg := uri.CookieParameterEncodingConfig{
-			Name:    "session",
-			Explode: true,
-		}
+	stage = "DecodeResponse"
+	result, err := decodeDeleteCredentialResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
 
-		if err := cookie.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.StringToString(params.Session))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode cookie")
+	return result, nil
+}
+
+// DiscardVault invokes discardVault operation.
+//
+// Discard the vault key wrapped for every credential of the user of the login session. Data
+// encrypted with it cannot be decrypted anymore.
+//
+// DELETE /vault
+func (c *Client) DiscardVault(ctx context.Context) (DiscardVaultRes, error) {
+	res, err := c.sendDiscardVault(ctx)
+	return res, err
+}
+
+func (c *Client) sendDiscardVault(ctx context.Context) (res DiscardVaultRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("discardVault"),
+		semconv.HTTPMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/vault"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "DiscardVault",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/vault"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
 	}
 
 	stage = "SendRequest"
@@ -263,7 +460,7 @@ func (c *Client) sendFinalizeAttestation(ctx context.Context, request FinalizeAt
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeFinalizeAttestationResponse(resp)
+	result, err := decodeDiscardVaultResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -271,20 +468,20 @@ func (c *Client) sendFinalizeAttestation(ctx context.Context, request FinalizeAt
 	return result, nil
 }
 
-// InitializeAssertion invokes initializeAssertion operation.
+// FinalizeAssertion invokes finalizeAssertion operation.
 //
-// Initialize Assertion.
+// Finalize Assertion.
 //
-// GET /assertion
-func (c *Client) InitializeAssertion(ctx context.Context) (InitializeAssertionRes, error) {
-	res, err := c.sendInitializeAssertion(ctx)
+// POST /assertion
+func (c *Client) FinalizeAssertion(ctx context.Context, request FinalizeAssertionReq, params FinalizeAssertionParams) (FinalizeAssertionRes, error) {
+	res, err := c.sendFinalizeAssertion(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendInitializeAssertion(ctx context.Context) (res InitializeAssertionRes, err error) {
+func (c *Client) sendFinalizeAssertion(ctx context.Context, request FinalizeAssertionReq, params FinalizeAssertionParams) (res FinalizeAssertionRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("initializeAssertion"),
-		semconv.HTTPMethodKey.String("GET"),
+		otelogen.OperationID("finalizeAssertion"),
+		semconv.HTTPMethodKey.String("POST"),
 		semconv.HTTPRouteKey.String("/assertion"),
 	}
 
@@ -300,7 +497,7 @@ func (c *Client) sendInitializeAssertion(ctx context.Context) (res InitializeAss
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "InitializeAssertion",
+	ctx, span := c.cfg.Tracer.Start(ctx, "FinalizeAssertion",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -321,11 +518,70 @@ func (c *Client) sendInitializeAssertion(ctx context.Context) (res InitializeAss
 	pathParts[0] = "/assertion"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "mediation" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "mediation",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Mediation.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeFinalizeAssertionRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "EncodeCookieParams"
+	cookie := uri.NewCookieEncoder(r)
+	{
+		// Encode "session" parameter.
+		cfg := uri.CookieParameterEncodingConfig{
+			Name:    "session",
+			Explode: true,
+		}
+
+		if err := cookie.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Session.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode cookie")
+		}
+	}
+	{
+		// Encode "conditional_session" parameter.
+		cfg := uri.CookieParameterEncodingConfig{
+			Name:    "conditional_session",
+			Explode: true,
+		}
+
+		if err := cookie.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ConditionalSession.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode cookie")
+		}
+	}
 
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
@@ -335,7 +591,7 @@ func (c *Client) sendInitializeAssertion(ctx context.Context) (res InitializeAss
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeInitializeAssertionResponse(resp)
+	result, err := decodeFinalizeAssertionResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -343,20 +599,20 @@ func (c *Client) sendInitializeAssertion(ctx context.Context) (res InitializeAss
 	return result, nil
 }
 
-// InitializeAttestation invokes initializeAttestation operation.
+// FinalizeAttestation invokes finalizeAttestation operation.
 //
-// Initialize Attestation.
+// Finalize Attestation.
 //
-// GET /attestation
-func (c *Client) InitializeAttestation(ctx context.Context) (InitializeAttestationRes, error) {
-	res, err := c.sendInitializeAttestation(ctx)
+// POST /attestation
+func (c *Client) FinalizeAttestation(ctx context.Context, request FinalizeAttestationReq, params FinalizeAttestationParams) (FinalizeAttestationRes, error) {
+	res, err := c.sendFinalizeAttestation(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendInitializeAttestation(ctx context.Context) (res InitializeAttestationRes, err error) {
+func (c *Client) sendFinalizeAttestation(ctx context.Context, request FinalizeAttestationReq, params FinalizeAttestationParams) (res FinalizeAttestationRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("initializeAttestation"),
-		semconv.HTTPMethodKey.String("GET"),
+		otelogen.OperationID("finalizeAttestation"),
+		semconv.HTTPMethodKey.String("POST"),
 		semconv.HTTPRouteKey.String("/attestation"),
 	}
 
@@ -372,7 +628,7 @@ func (c *Client) sendInitializeAttestation(ctx context.Context) (res InitializeA
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "InitializeAttestation",
+	ctx, span := c.cfg.Tracer.Start(ctx, "FinalizeAttestation",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -394,10 +650,29 @@ func (c *Client) sendInitializeAttestation(ctx context.Context) (res InitializeA
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeFinalizeAttestationRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "EncodeCookieParams"
+	cookie := uri.NewCookieEncoder(r)
+	{
+		// Encode "session" parameter.
+		cfg := uri.CookieParameterEncodingConfig{
+			Name:    "session",
+			Explode: true,
+		}
+
+		if err := cookie.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Session))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode cookie")
+		}
+	}
 
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
@@ -407,7 +682,7 @@ func (c *Client) sendInitializeAttestation(ctx context.Context) (res InitializeA
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeInitializeAttestationResponse(resp)
+	result, err := decodeFinalizeAttestationResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -415,23 +690,21 @@ func (c *Client) sendInitializeAttestation(ctx context.Context) (res InitializeA
 	return result, nil
 }
 
-// InitializeAttestationJSON invokes initializeAttestationJSON operation.
+// GetBackupStatus invokes getBackupStatus operation.
 //
-// Initialize Attestation JSON.
+// Report whether the passkeys of the user of the login session are backed up or only device-bound.
 //
-// Deprecated: schema marks this operation as deprecated.
-//
-// GET /attestation/json
-func (c *Client) InitializeAttestationJSON(ctx context.Context) (InitializeAttestationJSONRes, error) {
-	res, err := c.sendInitializeAttestationJSON(ctx)
+// GET /backup-status
+func (c *Client) GetBackupStatus(ctx context.Context) (GetBackupStatusRes, error) {
+	res, err := c.sendGetBackupStatus(ctx)
 	return res, err
 }
 
-func (c *Client) sendInitializeAttestationJSON(ctx context.Context) (res InitializeAttestationJSONRes, err error) {
+func (c *Client) sendGetBackupStatus(ctx context.Context) (res GetBackupStatusRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("initializeAttestationJSON"),
+		otelogen.OperationID("getBackupStatus"),
 		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/attestation/json"),
+		semconv.HTTPRouteKey.String("/backup-status"),
 	}
 
 	// Run stopwatch.
@@ -446,7 +719,7 @@ func (c *Client) sendInitializeAttestationJSON(ctx context.Context) (res Initial
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, "InitializeAttestationJSON",
+	ctx, span := c.cfg.Tracer.Start(ctx, "GetBackupStatus",
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -464,7 +737,7 @@ func (c *Client) sendInitializeAttestationJSON(ctx context.Context) (res Initial
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/attestation/json"
+	pathParts[0] = "/backup-status"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -481,7 +754,846 @@ func (c *Client) sendInitializeAttestationJSON(ctx context.Context) (res Initial
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeInitializeAttestationJSONResponse(resp)
+	result, err := decodeGetBackupStatusResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetMe invokes getMe operation.
+//
+// Get the user and the credential of the login session.
+//
+// GET /me
+func (c *Client) GetMe(ctx context.Context) (GetMeRes, error) {
+	res, err := c.sendGetMe(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetMe(ctx context.Context) (res GetMeRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getMe"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/me"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "GetMe",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/me"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetMeResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetVaultKey invokes getVaultKey operation.
+//
+// Get the vault key wrapped for the credential the login session was asserted with. The client
+// unwraps it with the prf output of that login.
+//
+// GET /vault/key
+func (c *Client) GetVaultKey(ctx context.Context) (GetVaultKeyRes, error) {
+	res, err := c.sendGetVaultKey(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetVaultKey(ctx context.Context) (res GetVaultKeyRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getVaultKey"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/vault/key"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "GetVaultKey",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/vault/key"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetVaultKeyResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// InitializeAssertion invokes initializeAssertion operation.
+//
+// Initialize Assertion.
+//
+// GET /assertion
+func (c *Client) InitializeAssertion(ctx context.Context, params InitializeAssertionParams) (InitializeAssertionRes, error) {
+	res, err := c.sendInitializeAssertion(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendInitializeAssertion(ctx context.Context, params InitializeAssertionParams) (res InitializeAssertionRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("initializeAssertion"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/assertion"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "InitializeAssertion",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/assertion"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "name" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Name.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "mediation" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "mediation",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Mediation.Get(); ok {
+				return e.EncodeValue(conv.StringToString(string(val)))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeInitializeAssertionResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// InitializeAttestation invokes initializeAttestation operation.
+//
+// Initialize Attestation.
+//
+// GET /attestation
+func (c *Client) InitializeAttestation(ctx context.Context, params InitializeAttestationParams) (InitializeAttestationRes, error) {
+	res, err := c.sendInitializeAttestation(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendInitializeAttestation(ctx context.Context, params InitializeAttestationParams) (res InitializeAttestationRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("initializeAttestation"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/attestation"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "InitializeAttestation",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/attestation"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "name" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "displayName" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "displayName",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.DisplayName.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeInitializeAttestationResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// InspectAttestation invokes inspectAttestation operation.
+//
+// Decode the attestation object and the client data kept with a credential. The login user must be
+// an admin.
+//
+// GET /admin/credentials/{credentialId}/attestation
+func (c *Client) InspectAttestation(ctx context.Context, params InspectAttestationParams) (InspectAttestationRes, error) {
+	res, err := c.sendInspectAttestation(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendInspectAttestation(ctx context.Context, params InspectAttestationParams) (res InspectAttestationRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("inspectAttestation"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/admin/credentials/{credentialId}/attestation"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "InspectAttestation",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/admin/credentials/"
+	{
+		// Encode "credentialId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "credentialId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.CredentialId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/attestation"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeInspectAttestationResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListAuditEvents invokes listAuditEvents operation.
+//
+// List the audit events of the user of the login session, newest first.
+//
+// GET /audit
+func (c *Client) ListAuditEvents(ctx context.Context) (ListAuditEventsRes, error) {
+	res, err := c.sendListAuditEvents(ctx)
+	return res, err
+}
+
+func (c *Client) sendListAuditEvents(ctx context.Context) (res ListAuditEventsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listAuditEvents"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/audit"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "ListAuditEvents",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/audit"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListAuditEventsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ListCredentials invokes listCredentials operation.
+//
+// List the credentials of the user of the login session in creation order.
+//
+// GET /credentials
+func (c *Client) ListCredentials(ctx context.Context) (ListCredentialsRes, error) {
+	res, err := c.sendListCredentials(ctx)
+	return res, err
+}
+
+func (c *Client) sendListCredentials(ctx context.Context) (res ListCredentialsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listCredentials"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/credentials"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "ListCredentials",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/credentials"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeListCredentialsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// Logout invokes logout operation.
+//
+// Logout.
+//
+// POST /logout
+func (c *Client) Logout(ctx context.Context) (*LogoutNoContent, error) {
+	res, err := c.sendLogout(ctx)
+	return res, err
+}
+
+func (c *Client) sendLogout(ctx context.Context) (res *LogoutNoContent, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("logout"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/logout"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "Logout",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/logout"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeLogoutResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PutVaultKey invokes putVaultKey operation.
+//
+// Store the vault key wrapped for the credential the login session was asserted with. Every
+// credential of the user must wrap the same key.
+//
+// PUT /vault/key
+func (c *Client) PutVaultKey(ctx context.Context, request *PutVaultKeyRequest) (PutVaultKeyRes, error) {
+	res, err := c.sendPutVaultKey(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendPutVaultKey(ctx context.Context, request *PutVaultKeyRequest) (res PutVaultKeyRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("putVaultKey"),
+		semconv.HTTPMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/vault/key"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "PutVaultKey",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/vault/key"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePutVaultKeyRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodePutVaultKeyResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// RenameCredential invokes renameCredential operation.
+//
+// Rename a credential of the user of the login session.
+//
+// PATCH /credentials/{credentialId}
+func (c *Client) RenameCredential(ctx context.Context, request *RenameCredentialRequest, params RenameCredentialParams) (RenameCredentialRes, error) {
+	res, err := c.sendRenameCredential(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendRenameCredential(ctx context.Context, request *RenameCredentialRequest, params RenameCredentialParams) (res RenameCredentialRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("renameCredential"),
+		semconv.HTTPMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/credentials/{credentialId}"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "RenameCredential",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/credentials/"
+	{
+		// Encode "credentialId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "credentialId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.CredentialId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeRenameCredentialRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRenameCredentialResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -20,20 +20,20 @@ import (
 	"github.com/ogen-go/ogen/otelogen"
 )
 
-// handleFinalizeAssertionRequest handles finalizeAssertion operation.
+// handleAbortAssertionRequest handles abortAssertion operation.
 //
-// Finalize Assertion.
+// Abort Assertion.
 //
-// POST /assertion
-func (s *Server) handleFinalizeAssertionRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /assertion
+func (s *Server) handleAbortAssertionRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("finalizeAssertion"),
-		semconv.HTTPMethodKey.String("POST"),
+		otelogen.OperationID("abortAssertion"),
+		semconv.HTTPMethodKey.String("DELETE"),
 		semconv.HTTPRouteKey.String("/assertion"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "FinalizeAssertion",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "AbortAssertion",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -58,42 +58,50 @@ func (s *Server) handleFinalizeAssertionRequest(args [0]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "FinalizeAssertion",
-			ID:   "finalizeAssertion",
+			Name: "AbortAssertion",
+			ID:   "abortAssertion",
 		}
 	)
-	request, close, err := s.decodeFinalizeAssertionRequest(r)
+	params, err := decodeAbortAssertionParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		recordError("DecodeRequest", err)
+		recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response FinalizeAssertionRes
+	var response *AbortAssertionNoContent
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "FinalizeAssertion",
-			OperationSummary: "Finalize Assertion",
-			OperationID:      "finalizeAssertion",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    "AbortAssertion",
+			OperationSummary: "Abort Assertion",
+			OperationID:      "abortAssertion",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "mediation",
+					In:   "query",
+				}: params.Mediation,
+				{
+					Name: "session",
+					In:   "cookie",
+				}: params.Session,
+				{
+					Name: "conditional_session",
+					In:   "cookie",
+				}: params.ConditionalSession,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = OptFinalizeAssertionRequest
-			Params   = struct{}
-			Response = FinalizeAssertionRes
+			Request  = struct{}
+			Params   = AbortAssertionParams
+			Response = *AbortAssertionNoContent
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -102,14 +110,14 @@ func (s *Server) handleFinalizeAssertionRequest(args [0]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			nil,
+			unpackAbortAssertionParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.FinalizeAssertion(ctx, request)
+				response, err = s.h.AbortAssertion(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.FinalizeAssertion(ctx, request)
+		response, err = s.h.AbortAssertion(ctx, params)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -117,7 +125,7 @@ func (s *Server) handleFinalizeAssertionRequest(args [0]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeFinalizeAssertionResponse(response, w, span); err != nil {
+	if err := encodeAbortAssertionResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -126,20 +134,22 @@ func (s *Server) handleFinalizeAssertionRequest(args [0]string, argsEscaped bool
 	}
 }
 
-// handleFinalizeAttestationRequest handles finalizeAttestation operation.
+// handleDeleteCredentialRequest handles deleteCredential operation.
 //
-// Finalize Attestation.
+// Delete a credential of the user of the login session. The last credential is kept as there is no
+// other way to log in, and so is the last credential holding the vault key unless the vault is
+// discarded.
 //
-// POST /attestation
-func (s *Server) handleFinalizeAttestationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /credentials/{credentialId}
+func (s *Server) handleDeleteCredentialRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("finalizeAttestation"),
-		semconv.HTTPMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/attestation"),
+		otelogen.OperationID("deleteCredential"),
+		semconv.HTTPMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/credentials/{credentialId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "FinalizeAttestation",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "DeleteCredential",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -164,11 +174,11 @@ func (s *Server) handleFinalizeAttestationRequest(args [0]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: "FinalizeAttestation",
-			ID:   "finalizeAttestation",
+			Name: "DeleteCredential",
+			ID:   "deleteCredential",
 		}
 	)
-	params, err := decodeFinalizeAttestationParams(args, argsEscaped, r)
+	params, err := decodeDeleteCredentialParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -178,43 +188,28 @@ func (s *Server) handleFinalizeAttestationRequest(args [0]string, argsEscaped bo
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeFinalizeAttestationRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response FinalizeAttestationRes
+	var response DeleteCredentialRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "FinalizeAttestation",
-			OperationSummary: "Finalize Attestation",
-			OperationID:      "finalizeAttestation",
-			Body:             request,
+			OperationName:    "DeleteCredential",
+			OperationSummary: "Delete Credential",
+			OperationID:      "deleteCredential",
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "session",
-					In:   "cookie",
-				}: params.Session,
+					Name: "credentialId",
+					In:   "path",
+				}: params.CredentialId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = FinalizeAttestationReq
-			Params   = FinalizeAttestationParams
-			Response = FinalizeAttestationRes
+			Request  = struct{}
+			Params   = DeleteCredentialParams
+			Response = DeleteCredentialRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -223,14 +218,14 @@ func (s *Server) handleFinalizeAttestationRequest(args [0]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackFinalizeAttestationParams,
+			unpackDeleteCredentialParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.FinalizeAttestation(ctx, request, params)
+				response, err = s.h.DeleteCredential(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.FinalizeAttestation(ctx, request, params)
+		response, err = s.h.DeleteCredential(ctx, params)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -238,7 +233,7 @@ func (s *Server) handleFinalizeAttestationRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeFinalizeAttestationResponse(response, w, span); err != nil {
+	if err := encodeDeleteCredentialResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -247,20 +242,21 @@ func (s *Server) handleFinalizeAttestationRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handleInitializeAssertionRequest handles initializeAssertion operation.
+// handleDiscardVaultRequest handles discardVault operation.
 //
-// Initialize Assertion.
+// Discard the vault key wrapped for every credential of the user of the login session. Data
+// encrypted with it cannot be decrypted anymore.
 //
-// GET /assertion
-func (s *Server) handleInitializeAssertionRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /vault
+func (s *Server) handleDiscardVaultRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("initializeAssertion"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/assertion"),
+		otelogen.OperationID("discardVault"),
+		semconv.HTTPMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/vault"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "InitializeAssertion",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "DiscardVault",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -286,13 +282,13 @@ func (s *Server) handleInitializeAssertionRequest(args [0]string, argsEscaped bo
 		err error
 	)
 
-	var response InitializeAssertionRes
+	var response DiscardVaultRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "InitializeAssertion",
-			OperationSummary: "Initialize Assertion",
-			OperationID:      "initializeAssertion",
+			OperationName:    "DiscardVault",
+			OperationSummary: "Discard Vault",
+			OperationID:      "discardVault",
 			Body:             nil,
 			Params:           middleware.Parameters{},
 			Raw:              r,
@@ -301,7 +297,7 @@ func (s *Server) handleInitializeAssertionRequest(args [0]string, argsEscaped bo
 		type (
 			Request  = struct{}
 			Params   = struct{}
-			Response = InitializeAssertionRes
+			Response = DiscardVaultRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -312,12 +308,12 @@ func (s *Server) handleInitializeAssertionRequest(args [0]string, argsEscaped bo
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.InitializeAssertion(ctx)
+				response, err = s.h.DiscardVault(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.InitializeAssertion(ctx)
+		response, err = s.h.DiscardVault(ctx)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -325,7 +321,7 @@ func (s *Server) handleInitializeAssertionRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeInitializeAssertionResponse(response, w, span); err != nil {
+	if err := encodeDiscardVaultResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -334,20 +330,20 @@ func (s *Server) handleInitializeAssertionRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handleInitializeAttestationRequest handles initializeAttestation operation.
+// handleFinalizeAssertionRequest handles finalizeAssertion operation.
 //
-// Initialize Attestation.
+// Finalize Assertion.
 //
-// GET /attestation
-func (s *Server) handleInitializeAttestationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /assertion
+func (s *Server) handleFinalizeAssertionRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("initializeAttestation"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/attestation"),
+		otelogen.OperationID("finalizeAssertion"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/assertion"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "InitializeAttestation",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "FinalizeAssertion",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -370,25 +366,67 @@ func (s *Server) handleInitializeAttestationRequest(args [0]string, argsEscaped
 			span.SetStatus(codes.Error, stage)
 			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "FinalizeAssertion",
+			ID:   "finalizeAssertion",
+		}
 	)
+	params, err := decodeFinalizeAssertionParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeFinalizeAssertionRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response InitializeAttestationRes
+	var response FinalizeAssertionRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "InitializeAttestation",
-			OperationSummary: "Initialize Attestation",
-			OperationID:      "initializeAttestation",
-			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    "FinalizeAssertion",
+			OperationSummary: "Finalize Assertion",
+			OperationID:      "finalizeAssertion",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "mediation",
+					In:   "query",
+				}: params.Mediation,
+				{
+					Name: "session",
+					In:   "cookie",
+				}: params.Session,
+				{
+					Name: "conditional_session",
+					In:   "cookie",
+				}: params.ConditionalSession,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = struct{}
-			Response = InitializeAttestationRes
+			Request  = FinalizeAssertionReq
+			Params   = FinalizeAssertionParams
+			Response = FinalizeAssertionRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -397,14 +435,14 @@ func (s *Server) handleInitializeAttestationRequest(args [0]string, argsEscaped
 		](
 			m,
 			mreq,
-			nil,
+			unpackFinalizeAssertionParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.InitializeAttestation(ctx)
+				response, err = s.h.FinalizeAssertion(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.InitializeAttestation(ctx)
+		response, err = s.h.FinalizeAssertion(ctx, request, params)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -412,7 +450,7 @@ func (s *Server) handleInitializeAttestationRequest(args [0]string, argsEscaped
 		return
 	}
 
-	if err := encodeInitializeAttestationResponse(response, w, span); err != nil {
+	if err := encodeFinalizeAssertionResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -421,22 +459,20 @@ func (s *Server) handleInitializeAttestationRequest(args [0]string, argsEscaped
 	}
 }
 
-// handleInitializeAttestationJSONRequest handles initializeAttestationJSON operation.
-//
-// Initialize Attestation JSON.
+// handleFinalizeAttestationRequest handles finalizeAttestation operation.
 //
-// Deprecated: schema marks this operation as deprecated.
+// Finalize Attestation.
 //
-// GET /attestation/json
-func (s *Server) handleInitializeAttestationJSONRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /attestation
+func (s *Server) handleFinalizeAttestationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("initializeAttestationJSON"),
-		semconv.HTTPMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/attestation/json"),
+		otelogen.OperationID("finalizeAttestation"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/attestation"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), "InitializeAttestationJSON",
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "FinalizeAttestation",
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -459,25 +495,59 @@ func (s *Server) handleInitializeAttestationJSONRequest(args [0]string, argsEsca
 			span.SetStatus(codes.Error, stage)
 			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "FinalizeAttestation",
+			ID:   "finalizeAttestation",
+		}
 	)
+	params, err := decodeFinalizeAttestationParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeFinalizeAttestationRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response InitializeAttestationJSONRes
+	var response FinalizeAttestationRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    "InitializeAttestationJSON",
-			OperationSummary: "Initialize Attestation JSON",
-			OperationID:      "initializeAttestationJSON",
-			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    "FinalizeAttestation",
+			OperationSummary: "Finalize Attestation",
+			OperationID:      "finalizeAttestation",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "session",
+					In:   "cookie",
+				}: params.Session,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = struct{}
-			Response = InitializeAttestationJSONRes
+			Request  = FinalizeAttestationReq
+			Params   = FinalizeAttestationParams
+			Response = FinalizeAttestationRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -486,14 +556,1092 @@ func (s *Server) handleInitializeAttestationJSONRequest(args [0]string, argsEsca
 		](
 			m,
 			mreq,
-			nil,
+			unpackFinalizeAttestationParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.FinalizeAttestation(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.FinalizeAttestation(ctx, request, params)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeFinalizeAttestationResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetBackupStatusRequest handles getBackupStatus operation.
+//
+// Report whether the passkeys of the user of the login session are backed up or only device-bound.
+//
+// GET /backup-status
+func (s *Server) handleGetBackupStatusRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getBackupStatus"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/backup-status"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetBackupStatus",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err error
+	)
+
+	var response GetBackupStatusRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetBackupStatus",
+			OperationSummary: "Get Backup Status",
+			OperationID:      "getBackupStatus",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetBackupStatusRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetBackupStatus(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetBackupStatus(ctx)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetBackupStatusResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetMeRequest handles getMe operation.
+//
+// Get the user and the credential of the login session.
+//
+// GET /me
+func (s *Server) handleGetMeRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getMe"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/me"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetMe",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err error
+	)
+
+	var response GetMeRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetMe",
+			OperationSummary: "Get Me",
+			OperationID:      "getMe",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetMeRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetMe(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetMe(ctx)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetMeResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetVaultKeyRequest handles getVaultKey operation.
+//
+// Get the vault key wrapped for the credential the login session was asserted with. The client
+// unwraps it with the prf output of that login.
+//
+// GET /vault/key
+func (s *Server) handleGetVaultKeyRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getVaultKey"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/vault/key"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "GetVaultKey",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err error
+	)
+
+	var response GetVaultKeyRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "GetVaultKey",
+			OperationSummary: "Get Vault Key",
+			OperationID:      "getVaultKey",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetVaultKeyRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetVaultKey(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetVaultKey(ctx)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetVaultKeyResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleInitializeAssertionRequest handles initializeAssertion operation.
+//
+// Initialize Assertion.
+//
+// GET /assertion
+func (s *Server) handleInitializeAssertionRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("initializeAssertion"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/assertion"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "InitializeAssertion",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "InitializeAssertion",
+			ID:   "initializeAssertion",
+		}
+	)
+	params, err := decodeInitializeAssertionParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response InitializeAssertionRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "InitializeAssertion",
+			OperationSummary: "Initialize Assertion",
+			OperationID:      "initializeAssertion",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "name",
+					In:   "query",
+				}: params.Name,
+				{
+					Name: "mediation",
+					In:   "query",
+				}: params.Mediation,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = InitializeAssertionParams
+			Response = InitializeAssertionRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackInitializeAssertionParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.InitializeAssertion(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.InitializeAssertion(ctx, params)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeInitializeAssertionResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleInitializeAttestationRequest handles initializeAttestation operation.
+//
+// Initialize Attestation.
+//
+// GET /attestation
+func (s *Server) handleInitializeAttestationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("initializeAttestation"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/attestation"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "InitializeAttestation",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "InitializeAttestation",
+			ID:   "initializeAttestation",
+		}
+	)
+	params, err := decodeInitializeAttestationParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response InitializeAttestationRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "InitializeAttestation",
+			OperationSummary: "Initialize Attestation",
+			OperationID:      "initializeAttestation",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "name",
+					In:   "query",
+				}: params.Name,
+				{
+					Name: "displayName",
+					In:   "query",
+				}: params.DisplayName,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = InitializeAttestationParams
+			Response = InitializeAttestationRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackInitializeAttestationParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.InitializeAttestation(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.InitializeAttestation(ctx, params)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeInitializeAttestationResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleInspectAttestationRequest handles inspectAttestation operation.
+//
+// Decode the attestation object and the client data kept with a credential. The login user must be
+// an admin.
+//
+// GET /admin/credentials/{credentialId}/attestation
+func (s *Server) handleInspectAttestationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("inspectAttestation"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/admin/credentials/{credentialId}/attestation"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "InspectAttestation",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "InspectAttestation",
+			ID:   "inspectAttestation",
+		}
+	)
+	params, err := decodeInspectAttestationParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response InspectAttestationRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "InspectAttestation",
+			OperationSummary: "Inspect Attestation",
+			OperationID:      "inspectAttestation",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "credentialId",
+					In:   "path",
+				}: params.CredentialId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = InspectAttestationParams
+			Response = InspectAttestationRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackInspectAttestationParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.InspectAttestation(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.InspectAttestation(ctx, params)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeInspectAttestationResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListAuditEventsRequest handles listAuditEvents operation.
+//
+// List the audit events of the user of the login session, newest first.
+//
+// GET /audit
+func (s *Server) handleListAuditEventsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listAuditEvents"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/audit"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListAuditEvents",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err error
+	)
+
+	var response ListAuditEventsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "ListAuditEvents",
+			OperationSummary: "List Audit Events",
+			OperationID:      "listAuditEvents",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = ListAuditEventsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListAuditEvents(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListAuditEvents(ctx)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListAuditEventsResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleListCredentialsRequest handles listCredentials operation.
+//
+// List the credentials of the user of the login session in creation order.
+//
+// GET /credentials
+func (s *Server) handleListCredentialsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("listCredentials"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/credentials"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "ListCredentials",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err error
+	)
+
+	var response ListCredentialsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "ListCredentials",
+			OperationSummary: "List Credentials",
+			OperationID:      "listCredentials",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = ListCredentialsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ListCredentials(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ListCredentials(ctx)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeListCredentialsResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleLogoutRequest handles logout operation.
+//
+// Logout.
+//
+// POST /logout
+func (s *Server) handleLogoutRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("logout"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/logout"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "Logout",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err error
+	)
+
+	var response *LogoutNoContent
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "Logout",
+			OperationSummary: "Logout",
+			OperationID:      "logout",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = *LogoutNoContent
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.Logout(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.Logout(ctx)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeLogoutResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePutVaultKeyRequest handles putVaultKey operation.
+//
+// Store the vault key wrapped for the credential the login session was asserted with. Every
+// credential of the user must wrap the same key.
+//
+// PUT /vault/key
+func (s *Server) handlePutVaultKeyRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("putVaultKey"),
+		semconv.HTTPMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/vault/key"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "PutVaultKey",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "PutVaultKey",
+			ID:   "putVaultKey",
+		}
+	)
+	request, close, err := s.decodePutVaultKeyRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response PutVaultKeyRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "PutVaultKey",
+			OperationSummary: "Put Vault Key",
+			OperationID:      "putVaultKey",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *PutVaultKeyRequest
+			Params   = struct{}
+			Response = PutVaultKeyRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.PutVaultKey(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.PutVaultKey(ctx, request)
+	}
+	if err != nil {
+		recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodePutVaultKeyResponse(response, w, span); err != nil {
+		recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleRenameCredentialRequest handles renameCredential operation.
+//
+// Rename a credential of the user of the login session.
+//
+// PATCH /credentials/{credentialId}
+func (s *Server) handleRenameCredentialRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("renameCredential"),
+		semconv.HTTPMethodKey.String("PATCH"),
+		semconv.HTTPRouteKey.String("/credentials/{credentialId}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), "RenameCredential",
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	s.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			s.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: "RenameCredential",
+			ID:   "renameCredential",
+		}
+	)
+	params, err := decodeRenameCredentialParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeRenameCredentialRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response RenameCredentialRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    "RenameCredential",
+			OperationSummary: "Rename Credential",
+			OperationID:      "renameCredential",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "credentialId",
+					In:   "path",
+				}: params.CredentialId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *RenameCredentialRequest
+			Params   = RenameCredentialParams
+			Response = RenameCredentialRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackRenameCredentialParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.InitializeAttestationJSON(ctx)
+				response, err = s.h.RenameCredential(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.InitializeAttestationJSON(ctx)
+		response, err = s.h.RenameCredential(ctx, request, params)
 	}
 	if err != nil {
 		recordError("Internal", err)
@@ -501,7 +1649,7 @@ func (s *Server) handleInitializeAttestationJSONRequest(args [0]string, argsEsca
 		return
 	}
 
-	if err := encodeInitializeAttestationJSONResponse(response, w, span); err != nil {
+	if err := encodeRenameCredentialResponse(response, w, span); err != nil {
 		recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
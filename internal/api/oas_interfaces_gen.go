@@ -1,22 +1,66 @@
 // Code generated by ogen, DO NOT EDIT.
 package api
 
+type DeleteCredentialRes interface {
+	deleteCredentialRes()
+}
+
+type DiscardVaultRes interface {
+	discardVaultRes()
+}
+
+type FinalizeAssertionReq interface {
+	finalizeAssertionReq()
+}
+
 type FinalizeAssertionRes interface {
 	finalizeAssertionRes()
 }
 
+type FinalizeAttestationReq interface {
+	finalizeAttestationReq()
+}
+
 type FinalizeAttestationRes interface {
 	finalizeAttestationRes()
 }
 
-type InitializeAssertionRes interface {
-	initializeAssertionRes()
+type GetBackupStatusRes interface {
+	getBackupStatusRes()
+}
+
+type GetMeRes interface {
+	getMeRes()
+}
+
+type GetVaultKeyRes interface {
+	getVaultKeyRes()
 }
 
-type InitializeAttestationJSONRes interface {
-	initializeAttestationJSONRes()
+type InitializeAssertionRes interface {
+	initializeAssertionRes()
 }
 
 type InitializeAttestationRes interface {
 	initializeAttestationRes()
 }
+
+type InspectAttestationRes interface {
+	inspectAttestationRes()
+}
+
+type ListAuditEventsRes interface {
+	listAuditEventsRes()
+}
+
+type ListCredentialsRes interface {
+	listCredentialsRes()
+}
+
+type PutVaultKeyRes interface {
+	putVaultKeyRes()
+}
+
+type RenameCredentialRes interface {
+	renameCredentialRes()
+}
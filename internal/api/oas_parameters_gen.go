@@ -4,6 +4,9 @@ package api
 
 import (
 	"net/http"
+	"net/url"
+
+	"github.com/go-faster/errors"
 
 	"github.com/ogen-go/ogen/conv"
 	"github.com/ogen-go/ogen/middleware"
@@ -12,6 +15,444 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
+// AbortAssertionParams is parameters of abortAssertion operation.
+type AbortAssertionParams struct {
+	// Mediation. conditional is used for passkey autofill.
+	Mediation OptMediation
+	// Session.
+	Session OptString
+	// Session for conditional mediation.
+	ConditionalSession OptString
+}
+
+func unpackAbortAssertionParams(packed middleware.Parameters) (params AbortAssertionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "mediation",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Mediation = v.(OptMediation)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "session",
+			In:   "cookie",
+		}
+		if v, ok := packed[key]; ok {
+			params.Session = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "conditional_session",
+			In:   "cookie",
+		}
+		if v, ok := packed[key]; ok {
+			params.ConditionalSession = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeAbortAssertionParams(args [0]string, argsEscaped bool, r *http.Request) (params AbortAssertionParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	c := uri.NewCookieDecoder(r)
+	// Set default value for query: mediation.
+	{
+		val := Mediation("optional")
+		params.Mediation.SetTo(val)
+	}
+	// Decode query: mediation.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "mediation",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMediationVal Mediation
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMediationVal = Mediation(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Mediation.SetTo(paramsDotMediationVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Mediation.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "mediation",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode cookie: session.
+	if err := func() error {
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "session",
+			Explode: true,
+		}
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSessionVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSessionVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Session.SetTo(paramsDotSessionVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "session",
+			In:   "cookie",
+			Err:  err,
+		}
+	}
+	// Decode cookie: conditional_session.
+	if err := func() error {
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "conditional_session",
+			Explode: true,
+		}
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotConditionalSessionVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotConditionalSessionVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ConditionalSession.SetTo(paramsDotConditionalSessionVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "conditional_session",
+			In:   "cookie",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteCredentialParams is parameters of deleteCredential operation.
+type DeleteCredentialParams struct {
+	// Base64url encoded credential id.
+	CredentialId string
+}
+
+func unpackDeleteCredentialParams(packed middleware.Parameters) (params DeleteCredentialParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "credentialId",
+			In:   "path",
+		}
+		params.CredentialId = packed[key].(string)
+	}
+	return params
+}
+
+func decodeDeleteCredentialParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteCredentialParams, _ error) {
+	// Decode path: credentialId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "credentialId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.CredentialId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "credentialId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// FinalizeAssertionParams is parameters of finalizeAssertion operation.
+type FinalizeAssertionParams struct {
+	// Mediation. conditional is used for passkey autofill.
+	Mediation OptMediation
+	// Session.
+	Session OptString
+	// Session for conditional mediation.
+	ConditionalSession OptString
+}
+
+func unpackFinalizeAssertionParams(packed middleware.Parameters) (params FinalizeAssertionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "mediation",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Mediation = v.(OptMediation)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "session",
+			In:   "cookie",
+		}
+		if v, ok := packed[key]; ok {
+			params.Session = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "conditional_session",
+			In:   "cookie",
+		}
+		if v, ok := packed[key]; ok {
+			params.ConditionalSession = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeFinalizeAssertionParams(args [0]string, argsEscaped bool, r *http.Request) (params FinalizeAssertionParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	c := uri.NewCookieDecoder(r)
+	// Set default value for query: mediation.
+	{
+		val := Mediation("optional")
+		params.Mediation.SetTo(val)
+	}
+	// Decode query: mediation.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "mediation",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMediationVal Mediation
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMediationVal = Mediation(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Mediation.SetTo(paramsDotMediationVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Mediation.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "mediation",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode cookie: session.
+	if err := func() error {
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "session",
+			Explode: true,
+		}
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSessionVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSessionVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Session.SetTo(paramsDotSessionVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "session",
+			In:   "cookie",
+			Err:  err,
+		}
+	}
+	// Decode cookie: conditional_session.
+	if err := func() error {
+		cfg := uri.CookieParameterDecodingConfig{
+			Name:    "conditional_session",
+			Explode: true,
+		}
+		if err := c.HasParam(cfg); err == nil {
+			if err := c.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotConditionalSessionVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotConditionalSessionVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ConditionalSession.SetTo(paramsDotConditionalSessionVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "conditional_session",
+			In:   "cookie",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // FinalizeAttestationParams is parameters of finalizeAttestation operation.
 type FinalizeAttestationParams struct {
 	// Session.
@@ -67,3 +508,444 @@ func decodeFinalizeAttestationParams(args [0]string, argsEscaped bool, r *http.R
 	}
 	return params, nil
 }
+
+// InitializeAssertionParams is parameters of initializeAssertion operation.
+type InitializeAssertionParams struct {
+	// User name. discoverable credential login is used when omitted.
+	Name OptString
+	// Mediation. conditional is used for passkey autofill.
+	Mediation OptMediation
+}
+
+func unpackInitializeAssertionParams(packed middleware.Parameters) (params InitializeAssertionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "name",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Name = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "mediation",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Mediation = v.(OptMediation)
+		}
+	}
+	return params
+}
+
+func decodeInitializeAssertionParams(args [0]string, argsEscaped bool, r *http.Request) (params InitializeAssertionParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: name.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotNameVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotNameVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Name.SetTo(paramsDotNameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Name.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:    1,
+							MinLengthSet: true,
+							MaxLength:    64,
+							MaxLengthSet: true,
+							Email:        false,
+							Hostname:     false,
+							Regex:        nil,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "name",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: mediation.
+	{
+		val := Mediation("optional")
+		params.Mediation.SetTo(val)
+	}
+	// Decode query: mediation.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "mediation",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMediationVal Mediation
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMediationVal = Mediation(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Mediation.SetTo(paramsDotMediationVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Mediation.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "mediation",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// InitializeAttestationParams is parameters of initializeAttestation operation.
+type InitializeAttestationParams struct {
+	// User name. a logged in user gives its own name to add a passkey.
+	Name string
+	// User display name.
+	DisplayName OptString
+}
+
+func unpackInitializeAttestationParams(packed middleware.Parameters) (params InitializeAttestationParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "name",
+			In:   "query",
+		}
+		params.Name = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "displayName",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.DisplayName = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeInitializeAttestationParams(args [0]string, argsEscaped bool, r *http.Request) (params InitializeAttestationParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: name.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Name = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:    1,
+					MinLengthSet: true,
+					MaxLength:    64,
+					MaxLengthSet: true,
+					Email:        false,
+					Hostname:     false,
+					Regex:        nil,
+				}).Validate(string(params.Name)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "name",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: displayName.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "displayName",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDisplayNameVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDisplayNameVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.DisplayName.SetTo(paramsDotDisplayNameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.DisplayName.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:    0,
+							MinLengthSet: false,
+							MaxLength:    64,
+							MaxLengthSet: true,
+							Email:        false,
+							Hostname:     false,
+							Regex:        nil,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "displayName",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// InspectAttestationParams is parameters of inspectAttestation operation.
+type InspectAttestationParams struct {
+	// Base64url encoded credential id.
+	CredentialId string
+}
+
+func unpackInspectAttestationParams(packed middleware.Parameters) (params InspectAttestationParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "credentialId",
+			In:   "path",
+		}
+		params.CredentialId = packed[key].(string)
+	}
+	return params
+}
+
+func decodeInspectAttestationParams(args [1]string, argsEscaped bool, r *http.Request) (params InspectAttestationParams, _ error) {
+	// Decode path: credentialId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "credentialId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.CredentialId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "credentialId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// RenameCredentialParams is parameters of renameCredential operation.
+type RenameCredentialParams struct {
+	// Base64url encoded credential id.
+	CredentialId string
+}
+
+func unpackRenameCredentialParams(packed middleware.Parameters) (params RenameCredentialParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "credentialId",
+			In:   "path",
+		}
+		params.CredentialId = packed[key].(string)
+	}
+	return params
+}
+
+func decodeRenameCredentialParams(args [1]string, argsEscaped bool, r *http.Request) (params RenameCredentialParams, _ error) {
+	// Decode path: credentialId.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "credentialId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.CredentialId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "credentialId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
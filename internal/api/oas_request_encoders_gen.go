@@ -6,37 +6,99 @@ import (
 	"bytes"
 	"net/http"
 
+	"github.com/go-faster/errors"
 	"github.com/go-faster/jx"
 
 	ht "github.com/ogen-go/ogen/http"
 )
 
 func encodeFinalizeAssertionRequest(
-	req OptFinalizeAssertionRequest,
+	req FinalizeAssertionReq,
 	r *http.Request,
 ) error {
-	const contentType = "application/json"
-	if !req.Set {
-		// Keep request with empty body if value is not set.
+	switch req := req.(type) {
+	case *FinalizeAssertionReqApplicationCbor:
+		const contentType = "application/cbor"
+		body := req
+		ht.SetBody(r, body, contentType)
+		return nil
+	case *FinalizeAssertionRequest:
+		const contentType = "application/json"
+		e := new(jx.Encoder)
+		{
+			req.Encode(e)
+		}
+		encoded := e.Bytes()
+		ht.SetBody(r, bytes.NewReader(encoded), contentType)
+		return nil
+	case *FinalizeAssertionReqApplicationXMsgpack:
+		const contentType = "application/x-msgpack"
+		body := req
+		ht.SetBody(r, body, contentType)
 		return nil
+	default:
+		return errors.Errorf("unexpected request type: %T", req)
 	}
-	e := new(jx.Encoder)
-	{
-		if req.Set {
+}
+
+func encodeFinalizeAttestationRequest(
+	req FinalizeAttestationReq,
+	r *http.Request,
+) error {
+	switch req := req.(type) {
+	case *FinalizeAttestationReqApplicationCbor:
+		const contentType = "application/cbor"
+		body := req
+		ht.SetBody(r, body, contentType)
+		return nil
+	case *FinalizeAttestationRequest:
+		const contentType = "application/json"
+		e := new(jx.Encoder)
+		{
 			req.Encode(e)
 		}
+		encoded := e.Bytes()
+		ht.SetBody(r, bytes.NewReader(encoded), contentType)
+		return nil
+	case *FinalizeAttestationReqApplicationXMsgpack:
+		const contentType = "application/x-msgpack"
+		body := req
+		ht.SetBody(r, body, contentType)
+		return nil
+	case *FinalizeAttestationReqTextPlain:
+		const contentType = "text/plain"
+		body := req
+		ht.SetBody(r, body, contentType)
+		return nil
+	default:
+		return errors.Errorf("unexpected request type: %T", req)
+	}
+}
+
+func encodePutVaultKeyRequest(
+	req *PutVaultKeyRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
 	}
 	encoded := e.Bytes()
 	ht.SetBody(r, bytes.NewReader(encoded), contentType)
 	return nil
 }
 
-func encodeFinalizeAttestationRequest(
-	req FinalizeAttestationReq,
+func encodeRenameCredentialRequest(
+	req *RenameCredentialRequest,
 	r *http.Request,
 ) error {
-	const contentType = "text/plain"
-	body := req
-	ht.SetBody(r, body, contentType)
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
 	return nil
 }
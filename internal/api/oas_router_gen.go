@@ -40,6 +40,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		s.notFound(w, r)
 		return
 	}
+	args := [1]string{}
 
 	// Static code generated router with unwrapped path search.
 	switch {
@@ -48,8 +49,8 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			break
 		}
 		switch elem[0] {
-		case '/': // Prefix: "/a"
-			if l := len("/a"); len(elem) >= l && elem[0:l] == "/a" {
+		case '/': // Prefix: "/"
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 				elem = elem[l:]
 			} else {
 				break
@@ -59,8 +60,121 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 			switch elem[0] {
-			case 's': // Prefix: "ssertion"
-				if l := len("ssertion"); len(elem) >= l && elem[0:l] == "ssertion" {
+			case 'a': // Prefix: "a"
+				if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'd': // Prefix: "dmin/credentials/"
+					if l := len("dmin/credentials/"); len(elem) >= l && elem[0:l] == "dmin/credentials/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "credentialId"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/attestation"
+						if l := len("/attestation"); len(elem) >= l && elem[0:l] == "/attestation" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleInspectAttestationRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+					}
+				case 's': // Prefix: "ssertion"
+					if l := len("ssertion"); len(elem) >= l && elem[0:l] == "ssertion" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "DELETE":
+							s.handleAbortAssertionRequest([0]string{}, elemIsEscaped, w, r)
+						case "GET":
+							s.handleInitializeAssertionRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleFinalizeAssertionRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE,GET,POST")
+						}
+
+						return
+					}
+				case 't': // Prefix: "ttestation"
+					if l := len("ttestation"); len(elem) >= l && elem[0:l] == "ttestation" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleInitializeAttestationRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleFinalizeAttestationRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+				case 'u': // Prefix: "udit"
+					if l := len("udit"); len(elem) >= l && elem[0:l] == "udit" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleListAuditEventsRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+				}
+			case 'b': // Prefix: "backup-status"
+				if l := len("backup-status"); len(elem) >= l && elem[0:l] == "backup-status" {
 					elem = elem[l:]
 				} else {
 					break
@@ -70,17 +184,15 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					// Leaf node.
 					switch r.Method {
 					case "GET":
-						s.handleInitializeAssertionRequest([0]string{}, elemIsEscaped, w, r)
-					case "POST":
-						s.handleFinalizeAssertionRequest([0]string{}, elemIsEscaped, w, r)
+						s.handleGetBackupStatusRequest([0]string{}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "GET,POST")
+						s.notAllowed(w, r, "GET")
 					}
 
 					return
 				}
-			case 't': // Prefix: "ttestation"
-				if l := len("ttestation"); len(elem) >= l && elem[0:l] == "ttestation" {
+			case 'c': // Prefix: "credentials"
+				if l := len("credentials"); len(elem) >= l && elem[0:l] == "credentials" {
 					elem = elem[l:]
 				} else {
 					break
@@ -89,18 +201,100 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				if len(elem) == 0 {
 					switch r.Method {
 					case "GET":
-						s.handleInitializeAttestationRequest([0]string{}, elemIsEscaped, w, r)
+						s.handleListCredentialsRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "credentialId"
+					// Leaf parameter
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "DELETE":
+							s.handleDeleteCredentialRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "PATCH":
+							s.handleRenameCredentialRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE,PATCH")
+						}
+
+						return
+					}
+				}
+			case 'l': // Prefix: "logout"
+				if l := len("logout"); len(elem) >= l && elem[0:l] == "logout" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
 					case "POST":
-						s.handleFinalizeAttestationRequest([0]string{}, elemIsEscaped, w, r)
+						s.handleLogoutRequest([0]string{}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "GET,POST")
+						s.notAllowed(w, r, "POST")
+					}
+
+					return
+				}
+			case 'm': // Prefix: "me"
+				if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "GET":
+						s.handleGetMeRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+			case 'v': // Prefix: "vault"
+				if l := len("vault"); len(elem) >= l && elem[0:l] == "vault" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "DELETE":
+						s.handleDiscardVaultRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "DELETE")
 					}
 
 					return
 				}
 				switch elem[0] {
-				case '/': // Prefix: "/json"
-					if l := len("/json"); len(elem) >= l && elem[0:l] == "/json" {
+				case '/': // Prefix: "/key"
+					if l := len("/key"); len(elem) >= l && elem[0:l] == "/key" {
 						elem = elem[l:]
 					} else {
 						break
@@ -110,9 +304,11 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						// Leaf node.
 						switch r.Method {
 						case "GET":
-							s.handleInitializeAttestationJSONRequest([0]string{}, elemIsEscaped, w, r)
+							s.handleGetVaultKeyRequest([0]string{}, elemIsEscaped, w, r)
+						case "PUT":
+							s.handlePutVaultKeyRequest([0]string{}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "GET")
+							s.notAllowed(w, r, "GET,PUT")
 						}
 
 						return
@@ -131,7 +327,7 @@ type Route struct {
 	operationID string
 	pathPattern string
 	count       int
-	args        [0]string
+	args        [1]string
 }
 
 // Name returns ogen operation name.
@@ -199,8 +395,8 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 			break
 		}
 		switch elem[0] {
-		case '/': // Prefix: "/a"
-			if l := len("/a"); len(elem) >= l && elem[0:l] == "/a" {
+		case '/': // Prefix: "/"
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 				elem = elem[l:]
 			} else {
 				break
@@ -210,8 +406,156 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				break
 			}
 			switch elem[0] {
-			case 's': // Prefix: "ssertion"
-				if l := len("ssertion"); len(elem) >= l && elem[0:l] == "ssertion" {
+			case 'a': // Prefix: "a"
+				if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'd': // Prefix: "dmin/credentials/"
+					if l := len("dmin/credentials/"); len(elem) >= l && elem[0:l] == "dmin/credentials/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "credentialId"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/attestation"
+						if l := len("/attestation"); len(elem) >= l && elem[0:l] == "/attestation" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								// Leaf: InspectAttestation
+								r.name = "InspectAttestation"
+								r.summary = "Inspect Attestation"
+								r.operationID = "inspectAttestation"
+								r.pathPattern = "/admin/credentials/{credentialId}/attestation"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+					}
+				case 's': // Prefix: "ssertion"
+					if l := len("ssertion"); len(elem) >= l && elem[0:l] == "ssertion" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "DELETE":
+							// Leaf: AbortAssertion
+							r.name = "AbortAssertion"
+							r.summary = "Abort Assertion"
+							r.operationID = "abortAssertion"
+							r.pathPattern = "/assertion"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "GET":
+							// Leaf: InitializeAssertion
+							r.name = "InitializeAssertion"
+							r.summary = "Initialize Assertion"
+							r.operationID = "initializeAssertion"
+							r.pathPattern = "/assertion"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							// Leaf: FinalizeAssertion
+							r.name = "FinalizeAssertion"
+							r.summary = "Finalize Assertion"
+							r.operationID = "finalizeAssertion"
+							r.pathPattern = "/assertion"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+				case 't': // Prefix: "ttestation"
+					if l := len("ttestation"); len(elem) >= l && elem[0:l] == "ttestation" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							// Leaf: InitializeAttestation
+							r.name = "InitializeAttestation"
+							r.summary = "Initialize Attestation"
+							r.operationID = "initializeAttestation"
+							r.pathPattern = "/attestation"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							// Leaf: FinalizeAttestation
+							r.name = "FinalizeAttestation"
+							r.summary = "Finalize Attestation"
+							r.operationID = "finalizeAttestation"
+							r.pathPattern = "/attestation"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+				case 'u': // Prefix: "udit"
+					if l := len("udit"); len(elem) >= l && elem[0:l] == "udit" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							// Leaf: ListAuditEvents
+							r.name = "ListAuditEvents"
+							r.summary = "List Audit Events"
+							r.operationID = "listAuditEvents"
+							r.pathPattern = "/audit"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+				}
+			case 'b': // Prefix: "backup-status"
+				if l := len("backup-status"); len(elem) >= l && elem[0:l] == "backup-status" {
 					elem = elem[l:]
 				} else {
 					break
@@ -220,20 +564,92 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				if len(elem) == 0 {
 					switch method {
 					case "GET":
-						// Leaf: InitializeAssertion
-						r.name = "InitializeAssertion"
-						r.summary = "Initialize Assertion"
-						r.operationID = "initializeAssertion"
-						r.pathPattern = "/assertion"
+						// Leaf: GetBackupStatus
+						r.name = "GetBackupStatus"
+						r.summary = "Get Backup Status"
+						r.operationID = "getBackupStatus"
+						r.pathPattern = "/backup-status"
 						r.args = args
 						r.count = 0
 						return r, true
+					default:
+						return
+					}
+				}
+			case 'c': // Prefix: "credentials"
+				if l := len("credentials"); len(elem) >= l && elem[0:l] == "credentials" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = "ListCredentials"
+						r.summary = "List Credentials"
+						r.operationID = "listCredentials"
+						r.pathPattern = "/credentials"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "credentialId"
+					// Leaf parameter
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						switch method {
+						case "DELETE":
+							// Leaf: DeleteCredential
+							r.name = "DeleteCredential"
+							r.summary = "Delete Credential"
+							r.operationID = "deleteCredential"
+							r.pathPattern = "/credentials/{credentialId}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "PATCH":
+							// Leaf: RenameCredential
+							r.name = "RenameCredential"
+							r.summary = "Rename Credential"
+							r.operationID = "renameCredential"
+							r.pathPattern = "/credentials/{credentialId}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+				}
+			case 'l': // Prefix: "logout"
+				if l := len("logout"); len(elem) >= l && elem[0:l] == "logout" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
 					case "POST":
-						// Leaf: FinalizeAssertion
-						r.name = "FinalizeAssertion"
-						r.summary = "Finalize Assertion"
-						r.operationID = "finalizeAssertion"
-						r.pathPattern = "/assertion"
+						// Leaf: Logout
+						r.name = "Logout"
+						r.summary = "Logout"
+						r.operationID = "logout"
+						r.pathPattern = "/logout"
 						r.args = args
 						r.count = 0
 						return r, true
@@ -241,8 +657,8 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						return
 					}
 				}
-			case 't': // Prefix: "ttestation"
-				if l := len("ttestation"); len(elem) >= l && elem[0:l] == "ttestation" {
+			case 'm': // Prefix: "me"
+				if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
 					elem = elem[l:]
 				} else {
 					break
@@ -251,18 +667,32 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				if len(elem) == 0 {
 					switch method {
 					case "GET":
-						r.name = "InitializeAttestation"
-						r.summary = "Initialize Attestation"
-						r.operationID = "initializeAttestation"
-						r.pathPattern = "/attestation"
+						// Leaf: GetMe
+						r.name = "GetMe"
+						r.summary = "Get Me"
+						r.operationID = "getMe"
+						r.pathPattern = "/me"
 						r.args = args
 						r.count = 0
 						return r, true
-					case "POST":
-						r.name = "FinalizeAttestation"
-						r.summary = "Finalize Attestation"
-						r.operationID = "finalizeAttestation"
-						r.pathPattern = "/attestation"
+					default:
+						return
+					}
+				}
+			case 'v': // Prefix: "vault"
+				if l := len("vault"); len(elem) >= l && elem[0:l] == "vault" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "DELETE":
+						r.name = "DiscardVault"
+						r.summary = "Discard Vault"
+						r.operationID = "discardVault"
+						r.pathPattern = "/vault"
 						r.args = args
 						r.count = 0
 						return r, true
@@ -271,8 +701,8 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					}
 				}
 				switch elem[0] {
-				case '/': // Prefix: "/json"
-					if l := len("/json"); len(elem) >= l && elem[0:l] == "/json" {
+				case '/': // Prefix: "/key"
+					if l := len("/key"); len(elem) >= l && elem[0:l] == "/key" {
 						elem = elem[l:]
 					} else {
 						break
@@ -281,11 +711,20 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					if len(elem) == 0 {
 						switch method {
 						case "GET":
-							// Leaf: InitializeAttestationJSON
-							r.name = "InitializeAttestationJSON"
-							r.summary = "Initialize Attestation JSON"
-							r.operationID = "initializeAttestationJSON"
-							r.pathPattern = "/attestation/json"
+							// Leaf: GetVaultKey
+							r.name = "GetVaultKey"
+							r.summary = "Get Vault Key"
+							r.operationID = "getVaultKey"
+							r.pathPattern = "/vault/key"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "PUT":
+							// Leaf: PutVaultKey
+							r.name = "PutVaultKey"
+							r.summary = "Put Vault Key"
+							r.operationID = "putVaultKey"
+							r.pathPattern = "/vault/key"
 							r.args = args
 							r.count = 0
 							return r, true
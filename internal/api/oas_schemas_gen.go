@@ -4,412 +4,2113 @@ package api
 
 import (
 	"io"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
 )
 
-// Ref: #/components/schemas/ErrorResponse
-type ErrorResponse struct {
-	Message string `json:"message"`
-}
+// AbortAssertionNoContent is response for AbortAssertion operation.
+type AbortAssertionNoContent struct {
+	SetCookie OptString
+}
+
+// GetSetCookie returns the value of SetCookie.
+func (s *AbortAssertionNoContent) GetSetCookie() OptString {
+	return s.SetCookie
+}
+
+// SetSetCookie sets the value of SetCookie.
+func (s *AbortAssertionNoContent) SetSetCookie(val OptString) {
+	s.SetCookie = val
+}
+
+// Ref: #/components/schemas/AttestationCertificate
+type AttestationCertificate struct {
+	Subject string `json:"subject"`
+	Issuer  string `json:"issuer"`
+	// Serial number in hex.
+	Serial    string    `json:"serial"`
+	NotBefore time.Time `json:"notBefore"`
+	NotAfter  time.Time `json:"notAfter"`
+	// SHA-256 fingerprint in hex.
+	Fingerprint string `json:"fingerprint"`
+}
+
+// GetSubject returns the value of Subject.
+func (s *AttestationCertificate) GetSubject() string {
+	return s.Subject
+}
+
+// GetIssuer returns the value of Issuer.
+func (s *AttestationCertificate) GetIssuer() string {
+	return s.Issuer
+}
+
+// GetSerial returns the value of Serial.
+func (s *AttestationCertificate) GetSerial() string {
+	return s.Serial
+}
+
+// GetNotBefore returns the value of NotBefore.
+func (s *AttestationCertificate) GetNotBefore() time.Time {
+	return s.NotBefore
+}
+
+// GetNotAfter returns the value of NotAfter.
+func (s *AttestationCertificate) GetNotAfter() time.Time {
+	return s.NotAfter
+}
+
+// GetFingerprint returns the value of Fingerprint.
+func (s *AttestationCertificate) GetFingerprint() string {
+	return s.Fingerprint
+}
+
+// SetSubject sets the value of Subject.
+func (s *AttestationCertificate) SetSubject(val string) {
+	s.Subject = val
+}
+
+// SetIssuer sets the value of Issuer.
+func (s *AttestationCertificate) SetIssuer(val string) {
+	s.Issuer = val
+}
+
+// SetSerial sets the value of Serial.
+func (s *AttestationCertificate) SetSerial(val string) {
+	s.Serial = val
+}
+
+// SetNotBefore sets the value of NotBefore.
+func (s *AttestationCertificate) SetNotBefore(val time.Time) {
+	s.NotBefore = val
+}
+
+// SetNotAfter sets the value of NotAfter.
+func (s *AttestationCertificate) SetNotAfter(val time.Time) {
+	s.NotAfter = val
+}
+
+// SetFingerprint sets the value of Fingerprint.
+func (s *AttestationCertificate) SetFingerprint(val string) {
+	s.Fingerprint = val
+}
+
+// Ref: #/components/schemas/AttestationReport
+type AttestationReport struct {
+	// Attestation statement format.
+	Format string `json:"format"`
+	// SHA-256 hash of the rp id in hex.
+	RpIdHash  string             `json:"rpIdHash"`
+	Flags     AuthenticatorFlags `json:"flags"`
+	Aaguid    string             `json:"aaguid"`
+	SignCount int64              `json:"signCount"`
+	// Base64url encoded credential id.
+	CredentialId string                   `json:"credentialId"`
+	PublicKey    CoseKey                  `json:"publicKey"`
+	Certificates []AttestationCertificate `json:"certificates"`
+	// Authenticator extension outputs. byte strings are base64url encoded.
+	Extensions OptAttestationReportExtensions `json:"extensions"`
+	ClientData ClientData                     `json:"clientData"`
+}
+
+// GetFormat returns the value of Format.
+func (s *AttestationReport) GetFormat() string {
+	return s.Format
+}
+
+// GetRpIdHash returns the value of RpIdHash.
+func (s *AttestationReport) GetRpIdHash() string {
+	return s.RpIdHash
+}
+
+// GetFlags returns the value of Flags.
+func (s *AttestationReport) GetFlags() AuthenticatorFlags {
+	return s.Flags
+}
+
+// GetAaguid returns the value of Aaguid.
+func (s *AttestationReport) GetAaguid() string {
+	return s.Aaguid
+}
+
+// GetSignCount returns the value of SignCount.
+func (s *AttestationReport) GetSignCount() int64 {
+	return s.SignCount
+}
+
+// GetCredentialId returns the value of CredentialId.
+func (s *AttestationReport) GetCredentialId() string {
+	return s.CredentialId
+}
+
+// GetPublicKey returns the value of PublicKey.
+func (s *AttestationReport) GetPublicKey() CoseKey {
+	return s.PublicKey
+}
+
+// GetCertificates returns the value of Certificates.
+func (s *AttestationReport) GetCertificates() []AttestationCertificate {
+	return s.Certificates
+}
+
+// GetExtensions returns the value of Extensions.
+func (s *AttestationReport) GetExtensions() OptAttestationReportExtensions {
+	return s.Extensions
+}
+
+// GetClientData returns the value of ClientData.
+func (s *AttestationReport) GetClientData() ClientData {
+	return s.ClientData
+}
+
+// SetFormat sets the value of Format.
+func (s *AttestationReport) SetFormat(val string) {
+	s.Format = val
+}
+
+// SetRpIdHash sets the value of RpIdHash.
+func (s *AttestationReport) SetRpIdHash(val string) {
+	s.RpIdHash = val
+}
+
+// SetFlags sets the value of Flags.
+func (s *AttestationReport) SetFlags(val AuthenticatorFlags) {
+	s.Flags = val
+}
+
+// SetAaguid sets the value of Aaguid.
+func (s *AttestationReport) SetAaguid(val string) {
+	s.Aaguid = val
+}
+
+// SetSignCount sets the value of SignCount.
+func (s *AttestationReport) SetSignCount(val int64) {
+	s.SignCount = val
+}
+
+// SetCredentialId sets the value of CredentialId.
+func (s *AttestationReport) SetCredentialId(val string) {
+	s.CredentialId = val
+}
+
+// SetPublicKey sets the value of PublicKey.
+func (s *AttestationReport) SetPublicKey(val CoseKey) {
+	s.PublicKey = val
+}
+
+// SetCertificates sets the value of Certificates.
+func (s *AttestationReport) SetCertificates(val []AttestationCertificate) {
+	s.Certificates = val
+}
+
+// SetExtensions sets the value of Extensions.
+func (s *AttestationReport) SetExtensions(val OptAttestationReportExtensions) {
+	s.Extensions = val
+}
+
+// SetClientData sets the value of ClientData.
+func (s *AttestationReport) SetClientData(val ClientData) {
+	s.ClientData = val
+}
+
+func (*AttestationReport) inspectAttestationRes() {}
+
+// Authenticator extension outputs. byte strings are base64url encoded.
+type AttestationReportExtensions map[string]jx.Raw
+
+func (s *AttestationReportExtensions) init() AttestationReportExtensions {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/AuditEvent
+type AuditEvent struct {
+	Time time.Time `json:"time"`
+	// Credential_registered, registration_rejected, credential_deleted, login, login_rejected,
+	// clone_warning, backup_state_changed, vault_key_wrapped or vault_discarded.
+	Type string `json:"type"`
+	// Base64url encoded credential id.
+	CredentialId OptString `json:"credentialId"`
+	Detail       OptString `json:"detail"`
+}
+
+// GetTime returns the value of Time.
+func (s *AuditEvent) GetTime() time.Time {
+	return s.Time
+}
+
+// GetType returns the value of Type.
+func (s *AuditEvent) GetType() string {
+	return s.Type
+}
+
+// GetCredentialId returns the value of CredentialId.
+func (s *AuditEvent) GetCredentialId() OptString {
+	return s.CredentialId
+}
+
+// GetDetail returns the value of Detail.
+func (s *AuditEvent) GetDetail() OptString {
+	return s.Detail
+}
+
+// SetTime sets the value of Time.
+func (s *AuditEvent) SetTime(val time.Time) {
+	s.Time = val
+}
+
+// SetType sets the value of Type.
+func (s *AuditEvent) SetType(val string) {
+	s.Type = val
+}
+
+// SetCredentialId sets the value of CredentialId.
+func (s *AuditEvent) SetCredentialId(val OptString) {
+	s.CredentialId = val
+}
+
+// SetDetail sets the value of Detail.
+func (s *AuditEvent) SetDetail(val OptString) {
+	s.Detail = val
+}
+
+// Ref: #/components/schemas/AuthenticatorFlags
+type AuthenticatorFlags struct {
+	UserPresent            bool `json:"userPresent"`
+	UserVerified           bool `json:"userVerified"`
+	BackupEligible         bool `json:"backupEligible"`
+	BackupState            bool `json:"backupState"`
+	AttestedCredentialData bool `json:"attestedCredentialData"`
+	ExtensionData          bool `json:"extensionData"`
+}
+
+// GetUserPresent returns the value of UserPresent.
+func (s *AuthenticatorFlags) GetUserPresent() bool {
+	return s.UserPresent
+}
+
+// GetUserVerified returns the value of UserVerified.
+func (s *AuthenticatorFlags) GetUserVerified() bool {
+	return s.UserVerified
+}
+
+// GetBackupEligible returns the value of BackupEligible.
+func (s *AuthenticatorFlags) GetBackupEligible() bool {
+	return s.BackupEligible
+}
+
+// GetBackupState returns the value of BackupState.
+func (s *AuthenticatorFlags) GetBackupState() bool {
+	return s.BackupState
+}
+
+// GetAttestedCredentialData returns the value of AttestedCredentialData.
+func (s *AuthenticatorFlags) GetAttestedCredentialData() bool {
+	return s.AttestedCredentialData
+}
+
+// GetExtensionData returns the value of ExtensionData.
+func (s *AuthenticatorFlags) GetExtensionData() bool {
+	return s.ExtensionData
+}
+
+// SetUserPresent sets the value of UserPresent.
+func (s *AuthenticatorFlags) SetUserPresent(val bool) {
+	s.UserPresent = val
+}
+
+// SetUserVerified sets the value of UserVerified.
+func (s *AuthenticatorFlags) SetUserVerified(val bool) {
+	s.UserVerified = val
+}
+
+// SetBackupEligible sets the value of BackupEligible.
+func (s *AuthenticatorFlags) SetBackupEligible(val bool) {
+	s.BackupEligible = val
+}
+
+// SetBackupState sets the value of BackupState.
+func (s *AuthenticatorFlags) SetBackupState(val bool) {
+	s.BackupState = val
+}
+
+// SetAttestedCredentialData sets the value of AttestedCredentialData.
+func (s *AuthenticatorFlags) SetAttestedCredentialData(val bool) {
+	s.AttestedCredentialData = val
+}
+
+// SetExtensionData sets the value of ExtensionData.
+func (s *AuthenticatorFlags) SetExtensionData(val bool) {
+	s.ExtensionData = val
+}
+
+// Ref: #/components/schemas/BackupStatus
+type BackupStatus struct {
+	// Number of credentials.
+	Credentials int `json:"credentials"`
+	// Number of credentials which can be backed up or synced.
+	BackupEligible int `json:"backupEligible"`
+	// Number of credentials which are backed up or synced.
+	BackedUp int `json:"backedUp"`
+	// Every credential is bound to its device, so losing the device locks the user out. add a backup
+	// credential.
+	DeviceBoundOnly bool `json:"deviceBoundOnly"`
+}
+
+// GetCredentials returns the value of Credentials.
+func (s *BackupStatus) GetCredentials() int {
+	return s.Credentials
+}
+
+// GetBackupEligible returns the value of BackupEligible.
+func (s *BackupStatus) GetBackupEligible() int {
+	return s.BackupEligible
+}
+
+// GetBackedUp returns the value of BackedUp.
+func (s *BackupStatus) GetBackedUp() int {
+	return s.BackedUp
+}
+
+// GetDeviceBoundOnly returns the value of DeviceBoundOnly.
+func (s *BackupStatus) GetDeviceBoundOnly() bool {
+	return s.DeviceBoundOnly
+}
+
+// SetCredentials sets the value of Credentials.
+func (s *BackupStatus) SetCredentials(val int) {
+	s.Credentials = val
+}
+
+// SetBackupEligible sets the value of BackupEligible.
+func (s *BackupStatus) SetBackupEligible(val int) {
+	s.BackupEligible = val
+}
+
+// SetBackedUp sets the value of BackedUp.
+func (s *BackupStatus) SetBackedUp(val int) {
+	s.BackedUp = val
+}
+
+// SetDeviceBoundOnly sets the value of DeviceBoundOnly.
+func (s *BackupStatus) SetDeviceBoundOnly(val bool) {
+	s.DeviceBoundOnly = val
+}
+
+func (*BackupStatus) getBackupStatusRes() {}
+
+// Ref: #/components/schemas/ClientData
+type ClientData struct {
+	Type        string    `json:"type"`
+	Challenge   string    `json:"challenge"`
+	Origin      string    `json:"origin"`
+	CrossOrigin OptBool   `json:"crossOrigin"`
+	TopOrigin   OptString `json:"topOrigin"`
+}
+
+// GetType returns the value of Type.
+func (s *ClientData) GetType() string {
+	return s.Type
+}
+
+// GetChallenge returns the value of Challenge.
+func (s *ClientData) GetChallenge() string {
+	return s.Challenge
+}
+
+// GetOrigin returns the value of Origin.
+func (s *ClientData) GetOrigin() string {
+	return s.Origin
+}
+
+// GetCrossOrigin returns the value of CrossOrigin.
+func (s *ClientData) GetCrossOrigin() OptBool {
+	return s.CrossOrigin
+}
+
+// GetTopOrigin returns the value of TopOrigin.
+func (s *ClientData) GetTopOrigin() OptString {
+	return s.TopOrigin
+}
+
+// SetType sets the value of Type.
+func (s *ClientData) SetType(val string) {
+	s.Type = val
+}
+
+// SetChallenge sets the value of Challenge.
+func (s *ClientData) SetChallenge(val string) {
+	s.Challenge = val
+}
+
+// SetOrigin sets the value of Origin.
+func (s *ClientData) SetOrigin(val string) {
+	s.Origin = val
+}
+
+// SetCrossOrigin sets the value of CrossOrigin.
+func (s *ClientData) SetCrossOrigin(val OptBool) {
+	s.CrossOrigin = val
+}
+
+// SetTopOrigin sets the value of TopOrigin.
+func (s *ClientData) SetTopOrigin(val OptString) {
+	s.TopOrigin = val
+}
+
+// Ref: #/components/schemas/CoseKey
+type CoseKey struct {
+	// COSE key type. 1 is OKP, 2 is EC2 and 3 is RSA.
+	Kty int64 `json:"kty"`
+	// COSE algorithm such as -7 (ES256) or -257 (RS256).
+	Alg int64 `json:"alg"`
+	// COSE elliptic curve of OKP and EC2 keys.
+	Crv OptInt64 `json:"crv"`
+	// Size of the key in bits.
+	Size int `json:"size"`
+}
+
+// GetKty returns the value of Kty.
+func (s *CoseKey) GetKty() int64 {
+	return s.Kty
+}
+
+// GetAlg returns the value of Alg.
+func (s *CoseKey) GetAlg() int64 {
+	return s.Alg
+}
+
+// GetCrv returns the value of Crv.
+func (s *CoseKey) GetCrv() OptInt64 {
+	return s.Crv
+}
+
+// GetSize returns the value of Size.
+func (s *CoseKey) GetSize() int {
+	return s.Size
+}
+
+// SetKty sets the value of Kty.
+func (s *CoseKey) SetKty(val int64) {
+	s.Kty = val
+}
+
+// SetAlg sets the value of Alg.
+func (s *CoseKey) SetAlg(val int64) {
+	s.Alg = val
+}
+
+// SetCrv sets the value of Crv.
+func (s *CoseKey) SetCrv(val OptInt64) {
+	s.Crv = val
+}
+
+// SetSize sets the value of Size.
+func (s *CoseKey) SetSize(val int) {
+	s.Size = val
+}
+
+// Ref: #/components/schemas/Credential
+type Credential struct {
+	// Base64url encoded credential id.
+	ID string `json:"id"`
+	// Name the user gave to the credential.
+	Nickname   OptString   `json:"nickname"`
+	CreatedAt  time.Time   `json:"createdAt"`
+	LastUsedAt OptDateTime `json:"lastUsedAt"`
+	// Signature counter of the last assertion.
+	SignCount int64 `json:"signCount"`
+	// The signature counter did not increase, so the authenticator may have been cloned.
+	CloneWarning bool `json:"cloneWarning"`
+	// The credential can be backed up or synced. false means it is bound to the device.
+	BackupEligible bool `json:"backupEligible"`
+	// The credential was backed up or synced at the last ceremony.
+	BackupState bool `json:"backupState"`
+	// Transports the authenticator supports such as internal, hybrid and usb.
+	Transports []string `json:"transports"`
+	// Platform or cross-platform if reported by the client.
+	Attachment OptString `json:"attachment"`
+	// AAGUID of the authenticator model.
+	Aaguid string `json:"aaguid"`
+	// Name of the authenticator model such as iCloud Keychain or YubiKey 5 if the AAGUID is known.
+	AuthenticatorName OptString `json:"authenticatorName"`
+	// Data URL of the icon of the authenticator model for light themes. missing unless icons are loaded
+	// by metadata.aaguidPath or the FIDO Metadata Service.
+	IconLight OptString `json:"iconLight"`
+	// Data URL of the icon of the authenticator model for dark themes. missing unless icons are loaded
+	// by metadata.aaguidPath or the FIDO Metadata Service.
+	IconDark OptString `json:"iconDark"`
+	// The root of the trust store which validated the attestation certificate chain.
+	TrustAnchor OptString `json:"trustAnchor"`
+	// Whether the credential is discoverable, reported by the credProps extension.
+	Discoverable OptBool `json:"discoverable"`
+	// Credential protection policy applied by the authenticator, reported by the credProtect extension.
+	CredProtect OptString `json:"credProtect"`
+	// Minimum PIN length of the authenticator, reported by the minPinLength extension.
+	MinPinLength OptInt `json:"minPinLength"`
+	// Whether the credential supports large blobs, reported by the largeBlob extension.
+	LargeBlobSupported OptBool `json:"largeBlobSupported"`
+	// Whether the vault key is wrapped for the credential.
+	VaultKey OptBool `json:"vaultKey"`
+	// Whether the credential supports the prf extension, reported at registration or confirmed by the
+	// client at login.
+	Prf OptBool `json:"prf"`
+	// Serial number in hex of the attestation certificate of the device identified by enterprise
+	// attestation.
+	DeviceSerial OptString `json:"deviceSerial"`
+	// SHA-256 fingerprint in hex of the attestation certificate of the device identified by enterprise
+	// attestation.
+	DeviceFingerprint OptString `json:"deviceFingerprint"`
+}
+
+// GetID returns the value of ID.
+func (s *Credential) GetID() string {
+	return s.ID
+}
+
+// GetNickname returns the value of Nickname.
+func (s *Credential) GetNickname() OptString {
+	return s.Nickname
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Credential) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetLastUsedAt returns the value of LastUsedAt.
+func (s *Credential) GetLastUsedAt() OptDateTime {
+	return s.LastUsedAt
+}
+
+// GetSignCount returns the value of SignCount.
+func (s *Credential) GetSignCount() int64 {
+	return s.SignCount
+}
+
+// GetCloneWarning returns the value of CloneWarning.
+func (s *Credential) GetCloneWarning() bool {
+	return s.CloneWarning
+}
+
+// GetBackupEligible returns the value of BackupEligible.
+func (s *Credential) GetBackupEligible() bool {
+	return s.BackupEligible
+}
+
+// GetBackupState returns the value of BackupState.
+func (s *Credential) GetBackupState() bool {
+	return s.BackupState
+}
+
+// GetTransports returns the value of Transports.
+func (s *Credential) GetTransports() []string {
+	return s.Transports
+}
+
+// GetAttachment returns the value of Attachment.
+func (s *Credential) GetAttachment() OptString {
+	return s.Attachment
+}
+
+// GetAaguid returns the value of Aaguid.
+func (s *Credential) GetAaguid() string {
+	return s.Aaguid
+}
+
+// GetAuthenticatorName returns the value of AuthenticatorName.
+func (s *Credential) GetAuthenticatorName() OptString {
+	return s.AuthenticatorName
+}
+
+// GetIconLight returns the value of IconLight.
+func (s *Credential) GetIconLight() OptString {
+	return s.IconLight
+}
+
+// GetIconDark returns the value of IconDark.
+func (s *Credential) GetIconDark() OptString {
+	return s.IconDark
+}
+
+// GetTrustAnchor returns the value of TrustAnchor.
+func (s *Credential) GetTrustAnchor() OptString {
+	return s.TrustAnchor
+}
+
+// GetDiscoverable returns the value of Discoverable.
+func (s *Credential) GetDiscoverable() OptBool {
+	return s.Discoverable
+}
+
+// GetCredProtect returns the value of CredProtect.
+func (s *Credential) GetCredProtect() OptString {
+	return s.CredProtect
+}
+
+// GetMinPinLength returns the value of MinPinLength.
+func (s *Credential) GetMinPinLength() OptInt {
+	return s.MinPinLength
+}
+
+// GetLargeBlobSupported returns the value of LargeBlobSupported.
+func (s *Credential) GetLargeBlobSupported() OptBool {
+	return s.LargeBlobSupported
+}
+
+// GetVaultKey returns the value of VaultKey.
+func (s *Credential) GetVaultKey() OptBool {
+	return s.VaultKey
+}
+
+// GetPrf returns the value of Prf.
+func (s *Credential) GetPrf() OptBool {
+	return s.Prf
+}
+
+// GetDeviceSerial returns the value of DeviceSerial.
+func (s *Credential) GetDeviceSerial() OptString {
+	return s.DeviceSerial
+}
+
+// GetDeviceFingerprint returns the value of DeviceFingerprint.
+func (s *Credential) GetDeviceFingerprint() OptString {
+	return s.DeviceFingerprint
+}
+
+// SetID sets the value of ID.
+func (s *Credential) SetID(val string) {
+	s.ID = val
+}
+
+// SetNickname sets the value of Nickname.
+func (s *Credential) SetNickname(val OptString) {
+	s.Nickname = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Credential) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetLastUsedAt sets the value of LastUsedAt.
+func (s *Credential) SetLastUsedAt(val OptDateTime) {
+	s.LastUsedAt = val
+}
+
+// SetSignCount sets the value of SignCount.
+func (s *Credential) SetSignCount(val int64) {
+	s.SignCount = val
+}
+
+// SetCloneWarning sets the value of CloneWarning.
+func (s *Credential) SetCloneWarning(val bool) {
+	s.CloneWarning = val
+}
+
+// SetBackupEligible sets the value of BackupEligible.
+func (s *Credential) SetBackupEligible(val bool) {
+	s.BackupEligible = val
+}
+
+// SetBackupState sets the value of BackupState.
+func (s *Credential) SetBackupState(val bool) {
+	s.BackupState = val
+}
+
+// SetTransports sets the value of Transports.
+func (s *Credential) SetTransports(val []string) {
+	s.Transports = val
+}
+
+// SetAttachment sets the value of Attachment.
+func (s *Credential) SetAttachment(val OptString) {
+	s.Attachment = val
+}
+
+// SetAaguid sets the value of Aaguid.
+func (s *Credential) SetAaguid(val string) {
+	s.Aaguid = val
+}
+
+// SetAuthenticatorName sets the value of AuthenticatorName.
+func (s *Credential) SetAuthenticatorName(val OptString) {
+	s.AuthenticatorName = val
+}
+
+// SetIconLight sets the value of IconLight.
+func (s *Credential) SetIconLight(val OptString) {
+	s.IconLight = val
+}
+
+// SetIconDark sets the value of IconDark.
+func (s *Credential) SetIconDark(val OptString) {
+	s.IconDark = val
+}
+
+// SetTrustAnchor sets the value of TrustAnchor.
+func (s *Credential) SetTrustAnchor(val OptString) {
+	s.TrustAnchor = val
+}
+
+// SetDiscoverable sets the value of Discoverable.
+func (s *Credential) SetDiscoverable(val OptBool) {
+	s.Discoverable = val
+}
+
+// SetCredProtect sets the value of CredProtect.
+func (s *Credential) SetCredProtect(val OptString) {
+	s.CredProtect = val
+}
+
+// SetMinPinLength sets the value of MinPinLength.
+func (s *Credential) SetMinPinLength(val OptInt) {
+	s.MinPinLength = val
+}
+
+// SetLargeBlobSupported sets the value of LargeBlobSupported.
+func (s *Credential) SetLargeBlobSupported(val OptBool) {
+	s.LargeBlobSupported = val
+}
+
+// SetVaultKey sets the value of VaultKey.
+func (s *Credential) SetVaultKey(val OptBool) {
+	s.VaultKey = val
+}
+
+// SetPrf sets the value of Prf.
+func (s *Credential) SetPrf(val OptBool) {
+	s.Prf = val
+}
+
+// SetDeviceSerial sets the value of DeviceSerial.
+func (s *Credential) SetDeviceSerial(val OptString) {
+	s.DeviceSerial = val
+}
+
+// SetDeviceFingerprint sets the value of DeviceFingerprint.
+func (s *Credential) SetDeviceFingerprint(val OptString) {
+	s.DeviceFingerprint = val
+}
+
+func (*Credential) renameCredentialRes() {}
+
+type DeleteCredentialConflict ErrorResponse
+
+func (*DeleteCredentialConflict) deleteCredentialRes() {}
+
+type DeleteCredentialInternalServerError ErrorResponse
+
+func (*DeleteCredentialInternalServerError) deleteCredentialRes() {}
+
+// DeleteCredentialNoContent is response for DeleteCredential operation.
+type DeleteCredentialNoContent struct{}
+
+func (*DeleteCredentialNoContent) deleteCredentialRes() {}
+
+type DeleteCredentialNotFound ErrorResponse
+
+func (*DeleteCredentialNotFound) deleteCredentialRes() {}
+
+type DeleteCredentialUnauthorized ErrorResponse
+
+func (*DeleteCredentialUnauthorized) deleteCredentialRes() {}
+
+type DiscardVaultInternalServerError ErrorResponse
+
+func (*DiscardVaultInternalServerError) discardVaultRes() {}
+
+// DiscardVaultNoContent is response for DiscardVault operation.
+type DiscardVaultNoContent struct{}
+
+func (*DiscardVaultNoContent) discardVaultRes() {}
+
+type DiscardVaultUnauthorized ErrorResponse
+
+func (*DiscardVaultUnauthorized) discardVaultRes() {}
+
+// Ref: #/components/schemas/ErrorResponse
+type ErrorResponse struct {
+	// Machine readable error code if any.
+	// challenge_replayed: the challenge of the session has already been used.
+	// session_expired: the session has expired.
+	// session_not_found: the session kept on the server does not exist or has already been used.
+	// session_mismatch: the session was issued for another ceremony or another login.
+	// credential_already_registered: the credential has already been registered.
+	// user_not_found: no user has the name.
+	// user_already_registered: another user has registered with the name during the registration.
+	// cloned_authenticator: the credential is suspected to be cloned and the login is rejected.
+	// backup_eligibility_changed: the backup eligibility of the credential has changed and the login is
+	// rejected.
+	// last_credential: the last credential cannot be deleted as there is no other way to log in.
+	// attestation_policy_violation: the authenticator is refused by the attestation policy. rule names
+	// the rule.
+	// vault_key_missing: the vault key has not been wrapped for the credential of the login session.
+	// vault_key_mismatch: the vault key is not the one wrapped for the other credentials of the user.
+	// last_vault_key: the credential holds the last wrapped copy of the vault key.
+	Code    OptString `json:"code"`
+	Message string    `json:"message"`
+	// The rule of the attestation policy refusing the authenticator.
+	Rule OptString `json:"rule"`
+}
+
+// GetCode returns the value of Code.
+func (s *ErrorResponse) GetCode() OptString {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *ErrorResponse) GetMessage() string {
+	return s.Message
+}
+
+// GetRule returns the value of Rule.
+func (s *ErrorResponse) GetRule() OptString {
+	return s.Rule
+}
+
+// SetCode sets the value of Code.
+func (s *ErrorResponse) SetCode(val OptString) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ErrorResponse) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetRule sets the value of Rule.
+func (s *ErrorResponse) SetRule(val OptString) {
+	s.Rule = val
+}
+
+func (*ErrorResponse) listAuditEventsRes() {}
+
+// ErrorResponseHeaders wraps ErrorResponse with response headers.
+type ErrorResponseHeaders struct {
+	SetCookie OptString
+	Response  ErrorResponse
+}
+
+// GetSetCookie returns the value of SetCookie.
+func (s *ErrorResponseHeaders) GetSetCookie() OptString {
+	return s.SetCookie
+}
+
+// GetResponse returns the value of Response.
+func (s *ErrorResponseHeaders) GetResponse() ErrorResponse {
+	return s.Response
+}
+
+// SetSetCookie sets the value of SetCookie.
+func (s *ErrorResponseHeaders) SetSetCookie(val OptString) {
+	s.SetCookie = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ErrorResponseHeaders) SetResponse(val ErrorResponse) {
+	s.Response = val
+}
+
+type FinalizeAssertionInternalServerError ErrorResponseHeaders
+
+func (*FinalizeAssertionInternalServerError) finalizeAssertionRes() {}
+
+// CBOR of the PublicKeyCredential with binary fields as byte strings.
+type FinalizeAssertionReqApplicationCbor struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s FinalizeAssertionReqApplicationCbor) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*FinalizeAssertionReqApplicationCbor) finalizeAssertionReq() {}
+
+// MessagePack of the PublicKeyCredential with binary fields as raw bytes.
+type FinalizeAssertionReqApplicationXMsgpack struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s FinalizeAssertionReqApplicationXMsgpack) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*FinalizeAssertionReqApplicationXMsgpack) finalizeAssertionReq() {}
+
+// Ref: #/components/schemas/FinalizeAssertionRequest
+type FinalizeAssertionRequest struct {
+	ID       OptString                           `json:"id"`
+	RawId    OptString                           `json:"rawId"`
+	Response OptFinalizeAssertionRequestResponse `json:"response"`
+	Type     OptString                           `json:"type"`
+	// GetClientExtensionResults() of the credential. ArrayBuffers are base64url encoded.
+	ClientExtensionResults OptFinalizeAssertionRequestClientExtensionResults `json:"clientExtensionResults"`
+}
+
+// GetID returns the value of ID.
+func (s *FinalizeAssertionRequest) GetID() OptString {
+	return s.ID
+}
+
+// GetRawId returns the value of RawId.
+func (s *FinalizeAssertionRequest) GetRawId() OptString {
+	return s.RawId
+}
+
+// GetResponse returns the value of Response.
+func (s *FinalizeAssertionRequest) GetResponse() OptFinalizeAssertionRequestResponse {
+	return s.Response
+}
+
+// GetType returns the value of Type.
+func (s *FinalizeAssertionRequest) GetType() OptString {
+	return s.Type
+}
+
+// GetClientExtensionResults returns the value of ClientExtensionResults.
+func (s *FinalizeAssertionRequest) GetClientExtensionResults() OptFinalizeAssertionRequestClientExtensionResults {
+	return s.ClientExtensionResults
+}
+
+// SetID sets the value of ID.
+func (s *FinalizeAssertionRequest) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetRawId sets the value of RawId.
+func (s *FinalizeAssertionRequest) SetRawId(val OptString) {
+	s.RawId = val
+}
+
+// SetResponse sets the value of Response.
+func (s *FinalizeAssertionRequest) SetResponse(val OptFinalizeAssertionRequestResponse) {
+	s.Response = val
+}
+
+// SetType sets the value of Type.
+func (s *FinalizeAssertionRequest) SetType(val OptString) {
+	s.Type = val
+}
+
+// SetClientExtensionResults sets the value of ClientExtensionResults.
+func (s *FinalizeAssertionRequest) SetClientExtensionResults(val OptFinalizeAssertionRequestClientExtensionResults) {
+	s.ClientExtensionResults = val
+}
+
+func (*FinalizeAssertionRequest) finalizeAssertionReq() {}
+
+// GetClientExtensionResults() of the credential. ArrayBuffers are base64url encoded.
+type FinalizeAssertionRequestClientExtensionResults map[string]jx.Raw
+
+func (s *FinalizeAssertionRequestClientExtensionResults) init() FinalizeAssertionRequestClientExtensionResults {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+type FinalizeAssertionRequestResponse struct {
+	AuthenticatorData OptString `json:"authenticatorData"`
+	ClientDataJSON    OptString `json:"clientDataJSON"`
+	Signature         OptString `json:"signature"`
+	UserHandle        OptString `json:"userHandle"`
+}
+
+// GetAuthenticatorData returns the value of AuthenticatorData.
+func (s *FinalizeAssertionRequestResponse) GetAuthenticatorData() OptString {
+	return s.AuthenticatorData
+}
+
+// GetClientDataJSON returns the value of ClientDataJSON.
+func (s *FinalizeAssertionRequestResponse) GetClientDataJSON() OptString {
+	return s.ClientDataJSON
+}
+
+// GetSignature returns the value of Signature.
+func (s *FinalizeAssertionRequestResponse) GetSignature() OptString {
+	return s.Signature
+}
+
+// GetUserHandle returns the value of UserHandle.
+func (s *FinalizeAssertionRequestResponse) GetUserHandle() OptString {
+	return s.UserHandle
+}
+
+// SetAuthenticatorData sets the value of AuthenticatorData.
+func (s *FinalizeAssertionRequestResponse) SetAuthenticatorData(val OptString) {
+	s.AuthenticatorData = val
+}
+
+// SetClientDataJSON sets the value of ClientDataJSON.
+func (s *FinalizeAssertionRequestResponse) SetClientDataJSON(val OptString) {
+	s.ClientDataJSON = val
+}
+
+// SetSignature sets the value of Signature.
+func (s *FinalizeAssertionRequestResponse) SetSignature(val OptString) {
+	s.Signature = val
+}
+
+// SetUserHandle sets the value of UserHandle.
+func (s *FinalizeAssertionRequestResponse) SetUserHandle(val OptString) {
+	s.UserHandle = val
+}
+
+// Ref: #/components/schemas/FinalizeAssertionResponse
+type FinalizeAssertionResponse struct {
+	// User name.
+	Name string `json:"name"`
+	// Base64url encoded credential id.
+	CredentialId string `json:"credentialId"`
+	UserVerified bool   `json:"userVerified"`
+	SignCount    int64  `json:"signCount"`
+}
+
+// GetName returns the value of Name.
+func (s *FinalizeAssertionResponse) GetName() string {
+	return s.Name
+}
+
+// GetCredentialId returns the value of CredentialId.
+func (s *FinalizeAssertionResponse) GetCredentialId() string {
+	return s.CredentialId
+}
+
+// GetUserVerified returns the value of UserVerified.
+func (s *FinalizeAssertionResponse) GetUserVerified() bool {
+	return s.UserVerified
+}
+
+// GetSignCount returns the value of SignCount.
+func (s *FinalizeAssertionResponse) GetSignCount() int64 {
+	return s.SignCount
+}
+
+// SetName sets the value of Name.
+func (s *FinalizeAssertionResponse) SetName(val string) {
+	s.Name = val
+}
+
+// SetCredentialId sets the value of CredentialId.
+func (s *FinalizeAssertionResponse) SetCredentialId(val string) {
+	s.CredentialId = val
+}
+
+// SetUserVerified sets the value of UserVerified.
+func (s *FinalizeAssertionResponse) SetUserVerified(val bool) {
+	s.UserVerified = val
+}
+
+// SetSignCount sets the value of SignCount.
+func (s *FinalizeAssertionResponse) SetSignCount(val int64) {
+	s.SignCount = val
+}
+
+// FinalizeAssertionResponseHeaders wraps FinalizeAssertionResponse with response headers.
+type FinalizeAssertionResponseHeaders struct {
+	SetCookie []string
+	Response  FinalizeAssertionResponse
+}
+
+// GetSetCookie returns the value of SetCookie.
+func (s *FinalizeAssertionResponseHeaders) GetSetCookie() []string {
+	return s.SetCookie
+}
+
+// GetResponse returns the value of Response.
+func (s *FinalizeAssertionResponseHeaders) GetResponse() FinalizeAssertionResponse {
+	return s.Response
+}
+
+// SetSetCookie sets the value of SetCookie.
+func (s *FinalizeAssertionResponseHeaders) SetSetCookie(val []string) {
+	s.SetCookie = val
+}
+
+// SetResponse sets the value of Response.
+func (s *FinalizeAssertionResponseHeaders) SetResponse(val FinalizeAssertionResponse) {
+	s.Response = val
+}
+
+func (*FinalizeAssertionResponseHeaders) finalizeAssertionRes() {}
+
+type FinalizeAssertionUnauthorized ErrorResponseHeaders
+
+func (*FinalizeAssertionUnauthorized) finalizeAssertionRes() {}
+
+type FinalizeAttestationBadRequest ErrorResponseHeaders
+
+func (*FinalizeAttestationBadRequest) finalizeAttestationRes() {}
+
+type FinalizeAttestationConflict ErrorResponseHeaders
+
+func (*FinalizeAttestationConflict) finalizeAttestationRes() {}
+
+type FinalizeAttestationForbidden ErrorResponseHeaders
+
+func (*FinalizeAttestationForbidden) finalizeAttestationRes() {}
+
+type FinalizeAttestationInternalServerError ErrorResponseHeaders
+
+func (*FinalizeAttestationInternalServerError) finalizeAttestationRes() {}
+
+// FinalizeAttestationOK is response for FinalizeAttestation operation.
+type FinalizeAttestationOK struct {
+	SetCookie OptString
+}
+
+// GetSetCookie returns the value of SetCookie.
+func (s *FinalizeAttestationOK) GetSetCookie() OptString {
+	return s.SetCookie
+}
+
+// SetSetCookie sets the value of SetCookie.
+func (s *FinalizeAttestationOK) SetSetCookie(val OptString) {
+	s.SetCookie = val
+}
+
+func (*FinalizeAttestationOK) finalizeAttestationRes() {}
+
+// CBOR of the PublicKeyCredential with binary fields as byte strings.
+type FinalizeAttestationReqApplicationCbor struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s FinalizeAttestationReqApplicationCbor) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*FinalizeAttestationReqApplicationCbor) finalizeAttestationReq() {}
+
+// MessagePack of the PublicKeyCredential with binary fields as raw bytes.
+type FinalizeAttestationReqApplicationXMsgpack struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s FinalizeAttestationReqApplicationXMsgpack) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*FinalizeAttestationReqApplicationXMsgpack) finalizeAttestationReq() {}
+
+// JSON of the PublicKeyCredential sent as text/plain, kept for older clients.
+type FinalizeAttestationReqTextPlain struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s FinalizeAttestationReqTextPlain) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*FinalizeAttestationReqTextPlain) finalizeAttestationReq() {}
+
+// Ref: #/components/schemas/FinalizeAttestationRequest
+type FinalizeAttestationRequest struct {
+	ID                      OptString                             `json:"id"`
+	RawId                   OptString                             `json:"rawId"`
+	Response                OptFinalizeAttestationRequestResponse `json:"response"`
+	Type                    OptString                             `json:"type"`
+	AuthenticatorAttachment OptString                             `json:"authenticatorAttachment"`
+	// GetClientExtensionResults() of the credential. ArrayBuffers are base64url encoded.
+	ClientExtensionResults OptFinalizeAttestationRequestClientExtensionResults `json:"clientExtensionResults"`
+}
+
+// GetID returns the value of ID.
+func (s *FinalizeAttestationRequest) GetID() OptString {
+	return s.ID
+}
+
+// GetRawId returns the value of RawId.
+func (s *FinalizeAttestationRequest) GetRawId() OptString {
+	return s.RawId
+}
+
+// GetResponse returns the value of Response.
+func (s *FinalizeAttestationRequest) GetResponse() OptFinalizeAttestationRequestResponse {
+	return s.Response
+}
+
+// GetType returns the value of Type.
+func (s *FinalizeAttestationRequest) GetType() OptString {
+	return s.Type
+}
+
+// GetAuthenticatorAttachment returns the value of AuthenticatorAttachment.
+func (s *FinalizeAttestationRequest) GetAuthenticatorAttachment() OptString {
+	return s.AuthenticatorAttachment
+}
+
+// GetClientExtensionResults returns the value of ClientExtensionResults.
+func (s *FinalizeAttestationRequest) GetClientExtensionResults() OptFinalizeAttestationRequestClientExtensionResults {
+	return s.ClientExtensionResults
+}
+
+// SetID sets the value of ID.
+func (s *FinalizeAttestationRequest) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetRawId sets the value of RawId.
+func (s *FinalizeAttestationRequest) SetRawId(val OptString) {
+	s.RawId = val
+}
+
+// SetResponse sets the value of Response.
+func (s *FinalizeAttestationRequest) SetResponse(val OptFinalizeAttestationRequestResponse) {
+	s.Response = val
+}
+
+// SetType sets the value of Type.
+func (s *FinalizeAttestationRequest) SetType(val OptString) {
+	s.Type = val
+}
+
+// SetAuthenticatorAttachment sets the value of AuthenticatorAttachment.
+func (s *FinalizeAttestationRequest) SetAuthenticatorAttachment(val OptString) {
+	s.AuthenticatorAttachment = val
+}
+
+// SetClientExtensionResults sets the value of ClientExtensionResults.
+func (s *FinalizeAttestationRequest) SetClientExtensionResults(val OptFinalizeAttestationRequestClientExtensionResults) {
+	s.ClientExtensionResults = val
+}
+
+func (*FinalizeAttestationRequest) finalizeAttestationReq() {}
+
+// GetClientExtensionResults() of the credential. ArrayBuffers are base64url encoded.
+type FinalizeAttestationRequestClientExtensionResults map[string]jx.Raw
+
+func (s *FinalizeAttestationRequestClientExtensionResults) init() FinalizeAttestationRequestClientExtensionResults {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+type FinalizeAttestationRequestResponse struct {
+	AttestationObject OptString `json:"attestationObject"`
+	ClientDataJSON    OptString `json:"clientDataJSON"`
+	Transports        []string  `json:"transports"`
+}
+
+// GetAttestationObject returns the value of AttestationObject.
+func (s *FinalizeAttestationRequestResponse) GetAttestationObject() OptString {
+	return s.AttestationObject
+}
+
+// GetClientDataJSON returns the value of ClientDataJSON.
+func (s *FinalizeAttestationRequestResponse) GetClientDataJSON() OptString {
+	return s.ClientDataJSON
+}
+
+// GetTransports returns the value of Transports.
+func (s *FinalizeAttestationRequestResponse) GetTransports() []string {
+	return s.Transports
+}
+
+// SetAttestationObject sets the value of AttestationObject.
+func (s *FinalizeAttestationRequestResponse) SetAttestationObject(val OptString) {
+	s.AttestationObject = val
+}
+
+// SetClientDataJSON sets the value of ClientDataJSON.
+func (s *FinalizeAttestationRequestResponse) SetClientDataJSON(val OptString) {
+	s.ClientDataJSON = val
+}
+
+// SetTransports sets the value of Transports.
+func (s *FinalizeAttestationRequestResponse) SetTransports(val []string) {
+	s.Transports = val
+}
+
+type GetBackupStatusInternalServerError ErrorResponse
+
+func (*GetBackupStatusInternalServerError) getBackupStatusRes() {}
+
+type GetBackupStatusUnauthorized ErrorResponse
+
+func (*GetBackupStatusUnauthorized) getBackupStatusRes() {}
+
+type GetMeInternalServerError ErrorResponse
+
+func (*GetMeInternalServerError) getMeRes() {}
+
+type GetMeUnauthorized ErrorResponse
+
+func (*GetMeUnauthorized) getMeRes() {}
+
+type GetVaultKeyInternalServerError ErrorResponse
+
+func (*GetVaultKeyInternalServerError) getVaultKeyRes() {}
+
+type GetVaultKeyNotFound ErrorResponse
+
+func (*GetVaultKeyNotFound) getVaultKeyRes() {}
+
+type GetVaultKeyUnauthorized ErrorResponse
+
+func (*GetVaultKeyUnauthorized) getVaultKeyRes() {}
+
+type InitializeAssertionBadRequest ErrorResponse
+
+func (*InitializeAssertionBadRequest) initializeAssertionRes() {}
+
+type InitializeAssertionInternalServerError ErrorResponse
+
+func (*InitializeAssertionInternalServerError) initializeAssertionRes() {}
+
+type InitializeAssertionNotAcceptable ErrorResponse
+
+func (*InitializeAssertionNotAcceptable) initializeAssertionRes() {}
+
+type InitializeAssertionNotFound ErrorResponse
+
+func (*InitializeAssertionNotFound) initializeAssertionRes() {}
+
+// CBOR of the options with binary fields as byte strings.
+type InitializeAssertionOKApplicationCbor struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s InitializeAssertionOKApplicationCbor) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// InitializeAssertionOKApplicationCborHeaders wraps InitializeAssertionOKApplicationCbor with response headers.
+type InitializeAssertionOKApplicationCborHeaders struct {
+	SetCookie OptString
+	Response  InitializeAssertionOKApplicationCbor
+}
+
+// GetSetCookie returns the value of SetCookie.
+func (s *InitializeAssertionOKApplicationCborHeaders) GetSetCookie() OptString {
+	return s.SetCookie
+}
+
+// GetResponse returns the value of Response.
+func (s *InitializeAssertionOKApplicationCborHeaders) GetResponse() InitializeAssertionOKApplicationCbor {
+	return s.Response
+}
+
+// SetSetCookie sets the value of SetCookie.
+func (s *InitializeAssertionOKApplicationCborHeaders) SetSetCookie(val OptString) {
+	s.SetCookie = val
+}
+
+// SetResponse sets the value of Response.
+func (s *InitializeAssertionOKApplicationCborHeaders) SetResponse(val InitializeAssertionOKApplicationCbor) {
+	s.Response = val
+}
+
+func (*InitializeAssertionOKApplicationCborHeaders) initializeAssertionRes() {}
+
+// MessagePack of the options with binary fields as raw bytes.
+type InitializeAssertionOKApplicationXMsgpack struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s InitializeAssertionOKApplicationXMsgpack) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// InitializeAssertionOKApplicationXMsgpackHeaders wraps InitializeAssertionOKApplicationXMsgpack with response headers.
+type InitializeAssertionOKApplicationXMsgpackHeaders struct {
+	SetCookie OptString
+	Response  InitializeAssertionOKApplicationXMsgpack
+}
+
+// GetSetCookie returns the value of SetCookie.
+func (s *InitializeAssertionOKApplicationXMsgpackHeaders) GetSetCookie() OptString {
+	return s.SetCookie
+}
+
+// GetResponse returns the value of Response.
+func (s *InitializeAssertionOKApplicationXMsgpackHeaders) GetResponse() InitializeAssertionOKApplicationXMsgpack {
+	return s.Response
+}
+
+// SetSetCookie sets the value of SetCookie.
+func (s *InitializeAssertionOKApplicationXMsgpackHeaders) SetSetCookie(val OptString) {
+	s.SetCookie = val
+}
+
+// SetResponse sets the value of Response.
+func (s *InitializeAssertionOKApplicationXMsgpackHeaders) SetResponse(val InitializeAssertionOKApplicationXMsgpack) {
+	s.Response = val
+}
+
+func (*InitializeAssertionOKApplicationXMsgpackHeaders) initializeAssertionRes() {}
+
+type InitializeAttestationConflict ErrorResponse
+
+func (*InitializeAttestationConflict) initializeAttestationRes() {}
+
+type InitializeAttestationInternalServerError ErrorResponse
+
+func (*InitializeAttestationInternalServerError) initializeAttestationRes() {}
+
+type InitializeAttestationNotAcceptable ErrorResponse
 
-// GetMessage returns the value of Message.
-func (s *ErrorResponse) GetMessage() string {
-	return s.Message
-}
+func (*InitializeAttestationNotAcceptable) initializeAttestationRes() {}
 
-// SetMessage sets the value of Message.
-func (s *ErrorResponse) SetMessage(val string) {
-	s.Message = val
+// CBOR of the options with binary fields as byte strings.
+type InitializeAttestationOKApplicationCbor struct {
+	Data io.Reader
 }
 
-func (*ErrorResponse) finalizeAssertionRes()         {}
-func (*ErrorResponse) initializeAssertionRes()       {}
-func (*ErrorResponse) initializeAttestationJSONRes() {}
-func (*ErrorResponse) initializeAttestationRes()     {}
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s InitializeAttestationOKApplicationCbor) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
 
-// ErrorResponseHeaders wraps ErrorResponse with response headers.
-type ErrorResponseHeaders struct {
+// InitializeAttestationOKApplicationCborHeaders wraps InitializeAttestationOKApplicationCbor with response headers.
+type InitializeAttestationOKApplicationCborHeaders struct {
 	SetCookie OptString
-	Response  ErrorResponse
+	Response  InitializeAttestationOKApplicationCbor
 }
 
 // GetSetCookie returns the value of SetCookie.
-func (s *ErrorResponseHeaders) GetSetCookie() OptString {
+func (s *InitializeAttestationOKApplicationCborHeaders) GetSetCookie() OptString {
 	return s.SetCookie
 }
 
 // GetResponse returns the value of Response.
-func (s *ErrorResponseHeaders) GetResponse() ErrorResponse {
+func (s *InitializeAttestationOKApplicationCborHeaders) GetResponse() InitializeAttestationOKApplicationCbor {
 	return s.Response
 }
 
 // SetSetCookie sets the value of SetCookie.
-func (s *ErrorResponseHeaders) SetSetCookie(val OptString) {
+func (s *InitializeAttestationOKApplicationCborHeaders) SetSetCookie(val OptString) {
 	s.SetCookie = val
 }
 
 // SetResponse sets the value of Response.
-func (s *ErrorResponseHeaders) SetResponse(val ErrorResponse) {
+func (s *InitializeAttestationOKApplicationCborHeaders) SetResponse(val InitializeAttestationOKApplicationCbor) {
 	s.Response = val
 }
 
-func (*ErrorResponseHeaders) finalizeAttestationRes() {}
+func (*InitializeAttestationOKApplicationCborHeaders) initializeAttestationRes() {}
 
-// Ref: #/components/schemas/FinalizeAssertionRequest
-type FinalizeAssertionRequest struct {
-	ID       OptString                           `json:"id"`
-	RawId    OptString                           `json:"rawId"`
-	Response OptFinalizeAssertionRequestResponse `json:"response"`
-	Type     OptString                           `json:"type"`
+// MessagePack of the options with binary fields as raw bytes.
+type InitializeAttestationOKApplicationXMsgpack struct {
+	Data io.Reader
 }
 
-// GetID returns the value of ID.
-func (s *FinalizeAssertionRequest) GetID() OptString {
-	return s.ID
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s InitializeAttestationOKApplicationXMsgpack) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// GetRawId returns the value of RawId.
-func (s *FinalizeAssertionRequest) GetRawId() OptString {
-	return s.RawId
+// InitializeAttestationOKApplicationXMsgpackHeaders wraps InitializeAttestationOKApplicationXMsgpack with response headers.
+type InitializeAttestationOKApplicationXMsgpackHeaders struct {
+	SetCookie OptString
+	Response  InitializeAttestationOKApplicationXMsgpack
+}
+
+// GetSetCookie returns the value of SetCookie.
+func (s *InitializeAttestationOKApplicationXMsgpackHeaders) GetSetCookie() OptString {
+	return s.SetCookie
 }
 
 // GetResponse returns the value of Response.
-func (s *FinalizeAssertionRequest) GetResponse() OptFinalizeAssertionRequestResponse {
+func (s *InitializeAttestationOKApplicationXMsgpackHeaders) GetResponse() InitializeAttestationOKApplicationXMsgpack {
 	return s.Response
 }
 
-// GetType returns the value of Type.
-func (s *FinalizeAssertionRequest) GetType() OptString {
-	return s.Type
+// SetSetCookie sets the value of SetCookie.
+func (s *InitializeAttestationOKApplicationXMsgpackHeaders) SetSetCookie(val OptString) {
+	s.SetCookie = val
 }
 
-// SetID sets the value of ID.
-func (s *FinalizeAssertionRequest) SetID(val OptString) {
-	s.ID = val
+// SetResponse sets the value of Response.
+func (s *InitializeAttestationOKApplicationXMsgpackHeaders) SetResponse(val InitializeAttestationOKApplicationXMsgpack) {
+	s.Response = val
 }
 
-// SetRawId sets the value of RawId.
-func (s *FinalizeAssertionRequest) SetRawId(val OptString) {
-	s.RawId = val
+func (*InitializeAttestationOKApplicationXMsgpackHeaders) initializeAttestationRes() {}
+
+type InspectAttestationForbidden ErrorResponse
+
+func (*InspectAttestationForbidden) inspectAttestationRes() {}
+
+type InspectAttestationInternalServerError ErrorResponse
+
+func (*InspectAttestationInternalServerError) inspectAttestationRes() {}
+
+type InspectAttestationNotFound ErrorResponse
+
+func (*InspectAttestationNotFound) inspectAttestationRes() {}
+
+type InspectAttestationUnauthorized ErrorResponse
+
+func (*InspectAttestationUnauthorized) inspectAttestationRes() {}
+
+type ListAuditEventsOKApplicationJSON []AuditEvent
+
+func (*ListAuditEventsOKApplicationJSON) listAuditEventsRes() {}
+
+type ListCredentialsInternalServerError ErrorResponse
+
+func (*ListCredentialsInternalServerError) listCredentialsRes() {}
+
+type ListCredentialsOKApplicationJSON []Credential
+
+func (*ListCredentialsOKApplicationJSON) listCredentialsRes() {}
+
+type ListCredentialsUnauthorized ErrorResponse
+
+func (*ListCredentialsUnauthorized) listCredentialsRes() {}
+
+// Ref: #/components/schemas/LoginSession
+type LoginSession struct {
+	CreatedAt  time.Time `json:"createdAt"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+	ExpiresAt  time.Time `json:"expiresAt"`
 }
 
-// SetResponse sets the value of Response.
-func (s *FinalizeAssertionRequest) SetResponse(val OptFinalizeAssertionRequestResponse) {
-	s.Response = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *LoginSession) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// SetType sets the value of Type.
-func (s *FinalizeAssertionRequest) SetType(val OptString) {
-	s.Type = val
+// GetLastSeenAt returns the value of LastSeenAt.
+func (s *LoginSession) GetLastSeenAt() time.Time {
+	return s.LastSeenAt
 }
 
-type FinalizeAssertionRequestResponse struct {
-	AuthenticatorData OptString `json:"authenticatorData"`
-	ClientDataJSON    OptString `json:"clientDataJSON"`
-	Signature         OptString `json:"signature"`
-	UserHandle        OptString `json:"userHandle"`
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *LoginSession) GetExpiresAt() time.Time {
+	return s.ExpiresAt
 }
 
-// GetAuthenticatorData returns the value of AuthenticatorData.
-func (s *FinalizeAssertionRequestResponse) GetAuthenticatorData() OptString {
-	return s.AuthenticatorData
+// SetCreatedAt sets the value of CreatedAt.
+func (s *LoginSession) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// GetClientDataJSON returns the value of ClientDataJSON.
-func (s *FinalizeAssertionRequestResponse) GetClientDataJSON() OptString {
-	return s.ClientDataJSON
+// SetLastSeenAt sets the value of LastSeenAt.
+func (s *LoginSession) SetLastSeenAt(val time.Time) {
+	s.LastSeenAt = val
 }
 
-// GetSignature returns the value of Signature.
-func (s *FinalizeAssertionRequestResponse) GetSignature() OptString {
-	return s.Signature
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *LoginSession) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
 }
 
-// GetUserHandle returns the value of UserHandle.
-func (s *FinalizeAssertionRequestResponse) GetUserHandle() OptString {
-	return s.UserHandle
+// LogoutNoContent is response for Logout operation.
+type LogoutNoContent struct {
+	SetCookie OptString
 }
 
-// SetAuthenticatorData sets the value of AuthenticatorData.
-func (s *FinalizeAssertionRequestResponse) SetAuthenticatorData(val OptString) {
-	s.AuthenticatorData = val
+// GetSetCookie returns the value of SetCookie.
+func (s *LogoutNoContent) GetSetCookie() OptString {
+	return s.SetCookie
 }
 
-// SetClientDataJSON sets the value of ClientDataJSON.
-func (s *FinalizeAssertionRequestResponse) SetClientDataJSON(val OptString) {
-	s.ClientDataJSON = val
+// SetSetCookie sets the value of SetCookie.
+func (s *LogoutNoContent) SetSetCookie(val OptString) {
+	s.SetCookie = val
 }
 
-// SetSignature sets the value of Signature.
-func (s *FinalizeAssertionRequestResponse) SetSignature(val OptString) {
-	s.Signature = val
+// Ref: #/components/schemas/Me
+type Me struct {
+	User       User         `json:"user"`
+	Credential Credential   `json:"credential"`
+	Session    LoginSession `json:"session"`
 }
 
-// SetUserHandle sets the value of UserHandle.
-func (s *FinalizeAssertionRequestResponse) SetUserHandle(val OptString) {
-	s.UserHandle = val
+// GetUser returns the value of User.
+func (s *Me) GetUser() User {
+	return s.User
 }
 
-// Ref: #/components/schemas/FinalizeAssertionResponse
-type FinalizeAssertionResponse struct {
-	ID       OptString                            `json:"id"`
-	RawId    OptString                            `json:"rawId"`
-	Response OptFinalizeAssertionResponseResponse `json:"response"`
-	Type     OptString                            `json:"type"`
+// GetCredential returns the value of Credential.
+func (s *Me) GetCredential() Credential {
+	return s.Credential
 }
 
-// GetID returns the value of ID.
-func (s *FinalizeAssertionResponse) GetID() OptString {
-	return s.ID
+// GetSession returns the value of Session.
+func (s *Me) GetSession() LoginSession {
+	return s.Session
 }
 
-// GetRawId returns the value of RawId.
-func (s *FinalizeAssertionResponse) GetRawId() OptString {
-	return s.RawId
+// SetUser sets the value of User.
+func (s *Me) SetUser(val User) {
+	s.User = val
 }
 
-// GetResponse returns the value of Response.
-func (s *FinalizeAssertionResponse) GetResponse() OptFinalizeAssertionResponseResponse {
-	return s.Response
+// SetCredential sets the value of Credential.
+func (s *Me) SetCredential(val Credential) {
+	s.Credential = val
 }
 
-// GetType returns the value of Type.
-func (s *FinalizeAssertionResponse) GetType() OptString {
-	return s.Type
+// SetSession sets the value of Session.
+func (s *Me) SetSession(val LoginSession) {
+	s.Session = val
 }
 
-// SetID sets the value of ID.
-func (s *FinalizeAssertionResponse) SetID(val OptString) {
-	s.ID = val
+func (*Me) getMeRes() {}
+
+// Ref: #/components/schemas/Mediation
+type Mediation string
+
+const (
+	MediationOptional    Mediation = "optional"
+	MediationConditional Mediation = "conditional"
+)
+
+// AllValues returns all Mediation values.
+func (Mediation) AllValues() []Mediation {
+	return []Mediation{
+		MediationOptional,
+		MediationConditional,
+	}
 }
 
-// SetRawId sets the value of RawId.
-func (s *FinalizeAssertionResponse) SetRawId(val OptString) {
-	s.RawId = val
+// MarshalText implements encoding.TextMarshaler.
+func (s Mediation) MarshalText() ([]byte, error) {
+	switch s {
+	case MediationOptional:
+		return []byte(s), nil
+	case MediationConditional:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetResponse sets the value of Response.
-func (s *FinalizeAssertionResponse) SetResponse(val OptFinalizeAssertionResponseResponse) {
-	s.Response = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Mediation) UnmarshalText(data []byte) error {
+	switch Mediation(data) {
+	case MediationOptional:
+		*s = MediationOptional
+		return nil
+	case MediationConditional:
+		*s = MediationConditional
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetType sets the value of Type.
-func (s *FinalizeAssertionResponse) SetType(val OptString) {
-	s.Type = val
+// NewOptAttestationReportExtensions returns new OptAttestationReportExtensions with value set to v.
+func NewOptAttestationReportExtensions(v AttestationReportExtensions) OptAttestationReportExtensions {
+	return OptAttestationReportExtensions{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAttestationReportExtensions is optional AttestationReportExtensions.
+type OptAttestationReportExtensions struct {
+	Value AttestationReportExtensions
+	Set   bool
 }
 
-func (*FinalizeAssertionResponse) finalizeAssertionRes() {}
+// IsSet returns true if OptAttestationReportExtensions was set.
+func (o OptAttestationReportExtensions) IsSet() bool { return o.Set }
 
-type FinalizeAssertionResponseResponse struct {
-	AuthenticatorData OptString `json:"authenticatorData"`
-	ClientDataJSON    OptString `json:"clientDataJSON"`
-	Signature         OptString `json:"signature"`
-	UserHandle        OptString `json:"userHandle"`
+// Reset unsets value.
+func (o *OptAttestationReportExtensions) Reset() {
+	var v AttestationReportExtensions
+	o.Value = v
+	o.Set = false
 }
 
-// GetAuthenticatorData returns the value of AuthenticatorData.
-func (s *FinalizeAssertionResponseResponse) GetAuthenticatorData() OptString {
-	return s.AuthenticatorData
+// SetTo sets value to v.
+func (o *OptAttestationReportExtensions) SetTo(v AttestationReportExtensions) {
+	o.Set = true
+	o.Value = v
 }
 
-// GetClientDataJSON returns the value of ClientDataJSON.
-func (s *FinalizeAssertionResponseResponse) GetClientDataJSON() OptString {
-	return s.ClientDataJSON
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAttestationReportExtensions) Get() (v AttestationReportExtensions, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// GetSignature returns the value of Signature.
-func (s *FinalizeAssertionResponseResponse) GetSignature() OptString {
-	return s.Signature
+// Or returns value if set, or given parameter if does not.
+func (o OptAttestationReportExtensions) Or(d AttestationReportExtensions) AttestationReportExtensions {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// GetUserHandle returns the value of UserHandle.
-func (s *FinalizeAssertionResponseResponse) GetUserHandle() OptString {
-	return s.UserHandle
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// SetAuthenticatorData sets the value of AuthenticatorData.
-func (s *FinalizeAssertionResponseResponse) SetAuthenticatorData(val OptString) {
-	s.AuthenticatorData = val
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
 }
 
-// SetClientDataJSON sets the value of ClientDataJSON.
-func (s *FinalizeAssertionResponseResponse) SetClientDataJSON(val OptString) {
-	s.ClientDataJSON = val
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
 }
 
-// SetSignature sets the value of Signature.
-func (s *FinalizeAssertionResponseResponse) SetSignature(val OptString) {
-	s.Signature = val
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
 }
 
-// SetUserHandle sets the value of UserHandle.
-func (s *FinalizeAssertionResponseResponse) SetUserHandle(val OptString) {
-	s.UserHandle = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// FinalizeAttestationOK is response for FinalizeAttestation operation.
-type FinalizeAttestationOK struct {
-	SetCookie OptString
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// GetSetCookie returns the value of SetCookie.
-func (s *FinalizeAttestationOK) GetSetCookie() OptString {
-	return s.SetCookie
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// SetSetCookie sets the value of SetCookie.
-func (s *FinalizeAttestationOK) SetSetCookie(val OptString) {
-	s.SetCookie = val
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFinalizeAssertionRequestClientExtensionResults returns new OptFinalizeAssertionRequestClientExtensionResults with value set to v.
+func NewOptFinalizeAssertionRequestClientExtensionResults(v FinalizeAssertionRequestClientExtensionResults) OptFinalizeAssertionRequestClientExtensionResults {
+	return OptFinalizeAssertionRequestClientExtensionResults{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFinalizeAssertionRequestClientExtensionResults is optional FinalizeAssertionRequestClientExtensionResults.
+type OptFinalizeAssertionRequestClientExtensionResults struct {
+	Value FinalizeAssertionRequestClientExtensionResults
+	Set   bool
 }
 
-func (*FinalizeAttestationOK) finalizeAttestationRes() {}
+// IsSet returns true if OptFinalizeAssertionRequestClientExtensionResults was set.
+func (o OptFinalizeAssertionRequestClientExtensionResults) IsSet() bool { return o.Set }
 
-type FinalizeAttestationReq struct {
-	Data io.Reader
+// Reset unsets value.
+func (o *OptFinalizeAssertionRequestClientExtensionResults) Reset() {
+	var v FinalizeAssertionRequestClientExtensionResults
+	o.Value = v
+	o.Set = false
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s FinalizeAttestationReq) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
+// SetTo sets value to v.
+func (o *OptFinalizeAssertionRequestClientExtensionResults) SetTo(v FinalizeAssertionRequestClientExtensionResults) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFinalizeAssertionRequestClientExtensionResults) Get() (v FinalizeAssertionRequestClientExtensionResults, ok bool) {
+	if !o.Set {
+		return v, false
 	}
-	return s.Data.Read(p)
+	return o.Value, true
 }
 
-// Ref: #/components/schemas/InitializeAssertionResponse
-type InitializeAssertionResponse struct {
-	Data OptString `json:"data"`
+// Or returns value if set, or given parameter if does not.
+func (o OptFinalizeAssertionRequestClientExtensionResults) Or(d FinalizeAssertionRequestClientExtensionResults) FinalizeAssertionRequestClientExtensionResults {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// GetData returns the value of Data.
-func (s *InitializeAssertionResponse) GetData() OptString {
-	return s.Data
+// NewOptFinalizeAssertionRequestResponse returns new OptFinalizeAssertionRequestResponse with value set to v.
+func NewOptFinalizeAssertionRequestResponse(v FinalizeAssertionRequestResponse) OptFinalizeAssertionRequestResponse {
+	return OptFinalizeAssertionRequestResponse{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// SetData sets the value of Data.
-func (s *InitializeAssertionResponse) SetData(val OptString) {
-	s.Data = val
+// OptFinalizeAssertionRequestResponse is optional FinalizeAssertionRequestResponse.
+type OptFinalizeAssertionRequestResponse struct {
+	Value FinalizeAssertionRequestResponse
+	Set   bool
 }
 
-func (*InitializeAssertionResponse) initializeAssertionRes() {}
+// IsSet returns true if OptFinalizeAssertionRequestResponse was set.
+func (o OptFinalizeAssertionRequestResponse) IsSet() bool { return o.Set }
 
-type InitializeAttestationJSONOK struct {
-	Data io.Reader
+// Reset unsets value.
+func (o *OptFinalizeAssertionRequestResponse) Reset() {
+	var v FinalizeAssertionRequestResponse
+	o.Value = v
+	o.Set = false
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s InitializeAttestationJSONOK) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
+// SetTo sets value to v.
+func (o *OptFinalizeAssertionRequestResponse) SetTo(v FinalizeAssertionRequestResponse) {
+	o.Set = true
+	o.Value = v
 }
 
-// InitializeAttestationJSONOKHeaders wraps InitializeAttestationJSONOK with response headers.
-type InitializeAttestationJSONOKHeaders struct {
-	SetCookie OptString
-	Response  InitializeAttestationJSONOK
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFinalizeAssertionRequestResponse) Get() (v FinalizeAssertionRequestResponse, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// GetSetCookie returns the value of SetCookie.
-func (s *InitializeAttestationJSONOKHeaders) GetSetCookie() OptString {
-	return s.SetCookie
+// Or returns value if set, or given parameter if does not.
+func (o OptFinalizeAssertionRequestResponse) Or(d FinalizeAssertionRequestResponse) FinalizeAssertionRequestResponse {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// GetResponse returns the value of Response.
-func (s *InitializeAttestationJSONOKHeaders) GetResponse() InitializeAttestationJSONOK {
-	return s.Response
+// NewOptFinalizeAttestationRequestClientExtensionResults returns new OptFinalizeAttestationRequestClientExtensionResults with value set to v.
+func NewOptFinalizeAttestationRequestClientExtensionResults(v FinalizeAttestationRequestClientExtensionResults) OptFinalizeAttestationRequestClientExtensionResults {
+	return OptFinalizeAttestationRequestClientExtensionResults{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// SetSetCookie sets the value of SetCookie.
-func (s *InitializeAttestationJSONOKHeaders) SetSetCookie(val OptString) {
-	s.SetCookie = val
+// OptFinalizeAttestationRequestClientExtensionResults is optional FinalizeAttestationRequestClientExtensionResults.
+type OptFinalizeAttestationRequestClientExtensionResults struct {
+	Value FinalizeAttestationRequestClientExtensionResults
+	Set   bool
 }
 
-// SetResponse sets the value of Response.
-func (s *InitializeAttestationJSONOKHeaders) SetResponse(val InitializeAttestationJSONOK) {
-	s.Response = val
+// IsSet returns true if OptFinalizeAttestationRequestClientExtensionResults was set.
+func (o OptFinalizeAttestationRequestClientExtensionResults) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFinalizeAttestationRequestClientExtensionResults) Reset() {
+	var v FinalizeAttestationRequestClientExtensionResults
+	o.Value = v
+	o.Set = false
 }
 
-func (*InitializeAttestationJSONOKHeaders) initializeAttestationJSONRes() {}
+// SetTo sets value to v.
+func (o *OptFinalizeAttestationRequestClientExtensionResults) SetTo(v FinalizeAttestationRequestClientExtensionResults) {
+	o.Set = true
+	o.Value = v
+}
 
-type InitializeAttestationOK struct {
-	Data io.Reader
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFinalizeAttestationRequestClientExtensionResults) Get() (v FinalizeAttestationRequestClientExtensionResults, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s InitializeAttestationOK) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
+// Or returns value if set, or given parameter if does not.
+func (o OptFinalizeAttestationRequestClientExtensionResults) Or(d FinalizeAttestationRequestClientExtensionResults) FinalizeAttestationRequestClientExtensionResults {
+	if v, ok := o.Get(); ok {
+		return v
 	}
-	return s.Data.Read(p)
+	return d
 }
 
-// InitializeAttestationOKHeaders wraps InitializeAttestationOK with response headers.
-type InitializeAttestationOKHeaders struct {
-	SetCookie OptString
-	Response  InitializeAttestationOK
+// NewOptFinalizeAttestationRequestResponse returns new OptFinalizeAttestationRequestResponse with value set to v.
+func NewOptFinalizeAttestationRequestResponse(v FinalizeAttestationRequestResponse) OptFinalizeAttestationRequestResponse {
+	return OptFinalizeAttestationRequestResponse{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetSetCookie returns the value of SetCookie.
-func (s *InitializeAttestationOKHeaders) GetSetCookie() OptString {
-	return s.SetCookie
+// OptFinalizeAttestationRequestResponse is optional FinalizeAttestationRequestResponse.
+type OptFinalizeAttestationRequestResponse struct {
+	Value FinalizeAttestationRequestResponse
+	Set   bool
 }
 
-// GetResponse returns the value of Response.
-func (s *InitializeAttestationOKHeaders) GetResponse() InitializeAttestationOK {
-	return s.Response
+// IsSet returns true if OptFinalizeAttestationRequestResponse was set.
+func (o OptFinalizeAttestationRequestResponse) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFinalizeAttestationRequestResponse) Reset() {
+	var v FinalizeAttestationRequestResponse
+	o.Value = v
+	o.Set = false
 }
 
-// SetSetCookie sets the value of SetCookie.
-func (s *InitializeAttestationOKHeaders) SetSetCookie(val OptString) {
-	s.SetCookie = val
+// SetTo sets value to v.
+func (o *OptFinalizeAttestationRequestResponse) SetTo(v FinalizeAttestationRequestResponse) {
+	o.Set = true
+	o.Value = v
 }
 
-// SetResponse sets the value of Response.
-func (s *InitializeAttestationOKHeaders) SetResponse(val InitializeAttestationOK) {
-	s.Response = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFinalizeAttestationRequestResponse) Get() (v FinalizeAttestationRequestResponse, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-func (*InitializeAttestationOKHeaders) initializeAttestationRes() {}
+// Or returns value if set, or given parameter if does not.
+func (o OptFinalizeAttestationRequestResponse) Or(d FinalizeAttestationRequestResponse) FinalizeAttestationRequestResponse {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
 
-// NewOptFinalizeAssertionRequest returns new OptFinalizeAssertionRequest with value set to v.
-func NewOptFinalizeAssertionRequest(v FinalizeAssertionRequest) OptFinalizeAssertionRequest {
-	return OptFinalizeAssertionRequest{
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptFinalizeAssertionRequest is optional FinalizeAssertionRequest.
-type OptFinalizeAssertionRequest struct {
-	Value FinalizeAssertionRequest
+// OptInt is optional int.
+type OptInt struct {
+	Value int
 	Set   bool
 }
 
-// IsSet returns true if OptFinalizeAssertionRequest was set.
-func (o OptFinalizeAssertionRequest) IsSet() bool { return o.Set }
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptFinalizeAssertionRequest) Reset() {
-	var v FinalizeAssertionRequest
+func (o *OptInt) Reset() {
+	var v int
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptFinalizeAssertionRequest) SetTo(v FinalizeAssertionRequest) {
+func (o *OptInt) SetTo(v int) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptFinalizeAssertionRequest) Get() (v FinalizeAssertionRequest, ok bool) {
+func (o OptInt) Get() (v int, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -417,45 +2118,45 @@ func (o OptFinalizeAssertionRequest) Get() (v FinalizeAssertionRequest, ok bool)
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptFinalizeAssertionRequest) Or(d FinalizeAssertionRequest) FinalizeAssertionRequest {
+func (o OptInt) Or(d int) int {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptFinalizeAssertionRequestResponse returns new OptFinalizeAssertionRequestResponse with value set to v.
-func NewOptFinalizeAssertionRequestResponse(v FinalizeAssertionRequestResponse) OptFinalizeAssertionRequestResponse {
-	return OptFinalizeAssertionRequestResponse{
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptFinalizeAssertionRequestResponse is optional FinalizeAssertionRequestResponse.
-type OptFinalizeAssertionRequestResponse struct {
-	Value FinalizeAssertionRequestResponse
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
 	Set   bool
 }
 
-// IsSet returns true if OptFinalizeAssertionRequestResponse was set.
-func (o OptFinalizeAssertionRequestResponse) IsSet() bool { return o.Set }
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptFinalizeAssertionRequestResponse) Reset() {
-	var v FinalizeAssertionRequestResponse
+func (o *OptInt64) Reset() {
+	var v int64
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptFinalizeAssertionRequestResponse) SetTo(v FinalizeAssertionRequestResponse) {
+func (o *OptInt64) SetTo(v int64) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptFinalizeAssertionRequestResponse) Get() (v FinalizeAssertionRequestResponse, ok bool) {
+func (o OptInt64) Get() (v int64, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -463,45 +2164,45 @@ func (o OptFinalizeAssertionRequestResponse) Get() (v FinalizeAssertionRequestRe
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptFinalizeAssertionRequestResponse) Or(d FinalizeAssertionRequestResponse) FinalizeAssertionRequestResponse {
+func (o OptInt64) Or(d int64) int64 {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptFinalizeAssertionResponseResponse returns new OptFinalizeAssertionResponseResponse with value set to v.
-func NewOptFinalizeAssertionResponseResponse(v FinalizeAssertionResponseResponse) OptFinalizeAssertionResponseResponse {
-	return OptFinalizeAssertionResponseResponse{
+// NewOptMediation returns new OptMediation with value set to v.
+func NewOptMediation(v Mediation) OptMediation {
+	return OptMediation{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptFinalizeAssertionResponseResponse is optional FinalizeAssertionResponseResponse.
-type OptFinalizeAssertionResponseResponse struct {
-	Value FinalizeAssertionResponseResponse
+// OptMediation is optional Mediation.
+type OptMediation struct {
+	Value Mediation
 	Set   bool
 }
 
-// IsSet returns true if OptFinalizeAssertionResponseResponse was set.
-func (o OptFinalizeAssertionResponseResponse) IsSet() bool { return o.Set }
+// IsSet returns true if OptMediation was set.
+func (o OptMediation) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptFinalizeAssertionResponseResponse) Reset() {
-	var v FinalizeAssertionResponseResponse
+func (o *OptMediation) Reset() {
+	var v Mediation
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptFinalizeAssertionResponseResponse) SetTo(v FinalizeAssertionResponseResponse) {
+func (o *OptMediation) SetTo(v Mediation) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptFinalizeAssertionResponseResponse) Get() (v FinalizeAssertionResponseResponse, ok bool) {
+func (o OptMediation) Get() (v Mediation, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -509,7 +2210,7 @@ func (o OptFinalizeAssertionResponseResponse) Get() (v FinalizeAssertionResponse
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptFinalizeAssertionResponseResponse) Or(d FinalizeAssertionResponseResponse) FinalizeAssertionResponseResponse {
+func (o OptMediation) Or(d Mediation) Mediation {
 	if v, ok := o.Get(); ok {
 		return v
 	}
@@ -561,3 +2262,228 @@ func (o OptString) Or(d string) string {
 	}
 	return d
 }
+
+type PublicKeyCredentialCreationOptions jx.Raw
+
+// PublicKeyCredentialCreationOptionsHeaders wraps PublicKeyCredentialCreationOptions with response headers.
+type PublicKeyCredentialCreationOptionsHeaders struct {
+	SetCookie OptString
+	Response  PublicKeyCredentialCreationOptions
+}
+
+// GetSetCookie returns the value of SetCookie.
+func (s *PublicKeyCredentialCreationOptionsHeaders) GetSetCookie() OptString {
+	return s.SetCookie
+}
+
+// GetResponse returns the value of Response.
+func (s *PublicKeyCredentialCreationOptionsHeaders) GetResponse() PublicKeyCredentialCreationOptions {
+	return s.Response
+}
+
+// SetSetCookie sets the value of SetCookie.
+func (s *PublicKeyCredentialCreationOptionsHeaders) SetSetCookie(val OptString) {
+	s.SetCookie = val
+}
+
+// SetResponse sets the value of Response.
+func (s *PublicKeyCredentialCreationOptionsHeaders) SetResponse(val PublicKeyCredentialCreationOptions) {
+	s.Response = val
+}
+
+func (*PublicKeyCredentialCreationOptionsHeaders) initializeAttestationRes() {}
+
+type PublicKeyCredentialRequestOptions jx.Raw
+
+// PublicKeyCredentialRequestOptionsHeaders wraps PublicKeyCredentialRequestOptions with response headers.
+type PublicKeyCredentialRequestOptionsHeaders struct {
+	SetCookie OptString
+	Response  PublicKeyCredentialRequestOptions
+}
+
+// GetSetCookie returns the value of SetCookie.
+func (s *PublicKeyCredentialRequestOptionsHeaders) GetSetCookie() OptString {
+	return s.SetCookie
+}
+
+// GetResponse returns the value of Response.
+func (s *PublicKeyCredentialRequestOptionsHeaders) GetResponse() PublicKeyCredentialRequestOptions {
+	return s.Response
+}
+
+// SetSetCookie sets the value of SetCookie.
+func (s *PublicKeyCredentialRequestOptionsHeaders) SetSetCookie(val OptString) {
+	s.SetCookie = val
+}
+
+// SetResponse sets the value of Response.
+func (s *PublicKeyCredentialRequestOptionsHeaders) SetResponse(val PublicKeyCredentialRequestOptions) {
+	s.Response = val
+}
+
+func (*PublicKeyCredentialRequestOptionsHeaders) initializeAssertionRes() {}
+
+type PutVaultKeyBadRequest ErrorResponse
+
+func (*PutVaultKeyBadRequest) putVaultKeyRes() {}
+
+type PutVaultKeyConflict ErrorResponse
+
+func (*PutVaultKeyConflict) putVaultKeyRes() {}
+
+type PutVaultKeyInternalServerError ErrorResponse
+
+func (*PutVaultKeyInternalServerError) putVaultKeyRes() {}
+
+// Ref: #/components/schemas/PutVaultKeyRequest
+type PutVaultKeyRequest struct {
+	// Id of the data encryption key chosen by the client.
+	KeyId string `json:"keyId"`
+	// Base64url encoded data encryption key wrapped with a key derived from the prf output of the
+	// credential.
+	WrappedKey string `json:"wrappedKey"`
+}
+
+// GetKeyId returns the value of KeyId.
+func (s *PutVaultKeyRequest) GetKeyId() string {
+	return s.KeyId
+}
+
+// GetWrappedKey returns the value of WrappedKey.
+func (s *PutVaultKeyRequest) GetWrappedKey() string {
+	return s.WrappedKey
+}
+
+// SetKeyId sets the value of KeyId.
+func (s *PutVaultKeyRequest) SetKeyId(val string) {
+	s.KeyId = val
+}
+
+// SetWrappedKey sets the value of WrappedKey.
+func (s *PutVaultKeyRequest) SetWrappedKey(val string) {
+	s.WrappedKey = val
+}
+
+type PutVaultKeyUnauthorized ErrorResponse
+
+func (*PutVaultKeyUnauthorized) putVaultKeyRes() {}
+
+type RenameCredentialInternalServerError ErrorResponse
+
+func (*RenameCredentialInternalServerError) renameCredentialRes() {}
+
+type RenameCredentialNotFound ErrorResponse
+
+func (*RenameCredentialNotFound) renameCredentialRes() {}
+
+// Ref: #/components/schemas/RenameCredentialRequest
+type RenameCredentialRequest struct {
+	Nickname string `json:"nickname"`
+}
+
+// GetNickname returns the value of Nickname.
+func (s *RenameCredentialRequest) GetNickname() string {
+	return s.Nickname
+}
+
+// SetNickname sets the value of Nickname.
+func (s *RenameCredentialRequest) SetNickname(val string) {
+	s.Nickname = val
+}
+
+type RenameCredentialUnauthorized ErrorResponse
+
+func (*RenameCredentialUnauthorized) renameCredentialRes() {}
+
+// Ref: #/components/schemas/User
+type User struct {
+	// Base64url encoded user handle.
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+// GetID returns the value of ID.
+func (s *User) GetID() string {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *User) GetName() string {
+	return s.Name
+}
+
+// GetDisplayName returns the value of DisplayName.
+func (s *User) GetDisplayName() string {
+	return s.DisplayName
+}
+
+// SetID sets the value of ID.
+func (s *User) SetID(val string) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *User) SetName(val string) {
+	s.Name = val
+}
+
+// SetDisplayName sets the value of DisplayName.
+func (s *User) SetDisplayName(val string) {
+	s.DisplayName = val
+}
+
+// Ref: #/components/schemas/VaultKey
+type VaultKey struct {
+	// Base64url encoded id of the credential the key is wrapped for.
+	CredentialId string `json:"credentialId"`
+	// Id of the data encryption key chosen by the client.
+	KeyId string `json:"keyId"`
+	// Base64url encoded data encryption key wrapped with a key derived from the prf output of the
+	// credential.
+	WrappedKey string    `json:"wrappedKey"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// GetCredentialId returns the value of CredentialId.
+func (s *VaultKey) GetCredentialId() string {
+	return s.CredentialId
+}
+
+// GetKeyId returns the value of KeyId.
+func (s *VaultKey) GetKeyId() string {
+	return s.KeyId
+}
+
+// GetWrappedKey returns the value of WrappedKey.
+func (s *VaultKey) GetWrappedKey() string {
+	return s.WrappedKey
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *VaultKey) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetCredentialId sets the value of CredentialId.
+func (s *VaultKey) SetCredentialId(val string) {
+	s.CredentialId = val
+}
+
+// SetKeyId sets the value of KeyId.
+func (s *VaultKey) SetKeyId(val string) {
+	s.KeyId = val
+}
+
+// SetWrappedKey sets the value of WrappedKey.
+func (s *VaultKey) SetWrappedKey(val string) {
+	s.WrappedKey = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *VaultKey) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*VaultKey) getVaultKeyRes() {}
+func (*VaultKey) putVaultKeyRes() {}
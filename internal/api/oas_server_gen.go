@@ -8,38 +8,108 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// AbortAssertion implements abortAssertion operation.
+	//
+	// Abort Assertion.
+	//
+	// DELETE /assertion
+	AbortAssertion(ctx context.Context, params AbortAssertionParams) (*AbortAssertionNoContent, error)
+	// DeleteCredential implements deleteCredential operation.
+	//
+	// Delete a credential of the user of the login session. The last credential is kept as there is no
+	// other way to log in, and so is the last credential holding the vault key unless the vault is
+	// discarded.
+	//
+	// DELETE /credentials/{credentialId}
+	DeleteCredential(ctx context.Context, params DeleteCredentialParams) (DeleteCredentialRes, error)
+	// DiscardVault implements discardVault operation.
+	//
+	// Discard the vault key wrapped for every credential of the user of the login session. Data
+	// encrypted with it cannot be decrypted anymore.
+	//
+	// DELETE /vault
+	DiscardVault(ctx context.Context) (DiscardVaultRes, error)
 	// FinalizeAssertion implements finalizeAssertion operation.
 	//
 	// Finalize Assertion.
 	//
 	// POST /assertion
-	FinalizeAssertion(ctx context.Context, req OptFinalizeAssertionRequest) (FinalizeAssertionRes, error)
+	FinalizeAssertion(ctx context.Context, req FinalizeAssertionReq, params FinalizeAssertionParams) (FinalizeAssertionRes, error)
 	// FinalizeAttestation implements finalizeAttestation operation.
 	//
 	// Finalize Attestation.
 	//
 	// POST /attestation
 	FinalizeAttestation(ctx context.Context, req FinalizeAttestationReq, params FinalizeAttestationParams) (FinalizeAttestationRes, error)
+	// GetBackupStatus implements getBackupStatus operation.
+	//
+	// Report whether the passkeys of the user of the login session are backed up or only device-bound.
+	//
+	// GET /backup-status
+	GetBackupStatus(ctx context.Context) (GetBackupStatusRes, error)
+	// GetMe implements getMe operation.
+	//
+	// Get the user and the credential of the login session.
+	//
+	// GET /me
+	GetMe(ctx context.Context) (GetMeRes, error)
+	// GetVaultKey implements getVaultKey operation.
+	//
+	// Get the vault key wrapped for the credential the login session was asserted with. The client
+	// unwraps it with the prf output of that login.
+	//
+	// GET /vault/key
+	GetVaultKey(ctx context.Context) (GetVaultKeyRes, error)
 	// InitializeAssertion implements initializeAssertion operation.
 	//
 	// Initialize Assertion.
 	//
 	// GET /assertion
-	InitializeAssertion(ctx context.Context) (InitializeAssertionRes, error)
+	InitializeAssertion(ctx context.Context, params InitializeAssertionParams) (InitializeAssertionRes, error)
 	// InitializeAttestation implements initializeAttestation operation.
 	//
 	// Initialize Attestation.
 	//
 	// GET /attestation
-	InitializeAttestation(ctx context.Context) (InitializeAttestationRes, error)
-	// InitializeAttestationJSON implements initializeAttestationJSON operation.
+	InitializeAttestation(ctx context.Context, params InitializeAttestationParams) (InitializeAttestationRes, error)
+	// InspectAttestation implements inspectAttestation operation.
+	//
+	// Decode the attestation object and the client data kept with a credential. The login user must be
+	// an admin.
+	//
+	// GET /admin/credentials/{credentialId}/attestation
+	InspectAttestation(ctx context.Context, params InspectAttestationParams) (InspectAttestationRes, error)
+	// ListAuditEvents implements listAuditEvents operation.
+	//
+	// List the audit events of the user of the login session, newest first.
+	//
+	// GET /audit
+	ListAuditEvents(ctx context.Context) (ListAuditEventsRes, error)
+	// ListCredentials implements listCredentials operation.
+	//
+	// List the credentials of the user of the login session in creation order.
+	//
+	// GET /credentials
+	ListCredentials(ctx context.Context) (ListCredentialsRes, error)
+	// Logout implements logout operation.
+	//
+	// Logout.
+	//
+	// POST /logout
+	Logout(ctx context.Context) (*LogoutNoContent, error)
+	// PutVaultKey implements putVaultKey operation.
+	//
+	// Store the vault key wrapped for the credential the login session was asserted with. Every
+	// credential of the user must wrap the same key.
 	//
-	// Initialize Attestation JSON.
+	// PUT /vault/key
+	PutVaultKey(ctx context.Context, req *PutVaultKeyRequest) (PutVaultKeyRes, error)
+	// RenameCredential implements renameCredential operation.
 	//
-	// Deprecated: schema marks this operation as deprecated.
+	// Rename a credential of the user of the login session.
 	//
-	// GET /attestation/json
-	InitializeAttestationJSON(ctx context.Context) (InitializeAttestationJSONRes, error)
+	// PATCH /credentials/{credentialId}
+	RenameCredential(ctx context.Context, req *RenameCredentialRequest, params RenameCredentialParams) (RenameCredentialRes, error)
 }
 
 // Server implements http server based on OpenAPI v3 specification and
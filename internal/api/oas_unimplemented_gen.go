@@ -13,12 +13,42 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// AbortAssertion implements abortAssertion operation.
+//
+// Abort Assertion.
+//
+// DELETE /assertion
+func (UnimplementedHandler) AbortAssertion(ctx context.Context, params AbortAssertionParams) (r *AbortAssertionNoContent, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteCredential implements deleteCredential operation.
+//
+// Delete a credential of the user of the login session. The last credential is kept as there is no
+// other way to log in, and so is the last credential holding the vault key unless the vault is
+// discarded.
+//
+// DELETE /credentials/{credentialId}
+func (UnimplementedHandler) DeleteCredential(ctx context.Context, params DeleteCredentialParams) (r DeleteCredentialRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DiscardVault implements discardVault operation.
+//
+// Discard the vault key wrapped for every credential of the user of the login session. Data
+// encrypted with it cannot be decrypted anymore.
+//
+// DELETE /vault
+func (UnimplementedHandler) DiscardVault(ctx context.Context) (r DiscardVaultRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // FinalizeAssertion implements finalizeAssertion operation.
 //
 // Finalize Assertion.
 //
 // POST /assertion
-func (UnimplementedHandler) FinalizeAssertion(ctx context.Context, req OptFinalizeAssertionRequest) (r FinalizeAssertionRes, _ error) {
+func (UnimplementedHandler) FinalizeAssertion(ctx context.Context, req FinalizeAssertionReq, params FinalizeAssertionParams) (r FinalizeAssertionRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -31,12 +61,40 @@ func (UnimplementedHandler) FinalizeAttestation(ctx context.Context, req Finaliz
 	return r, ht.ErrNotImplemented
 }
 
+// GetBackupStatus implements getBackupStatus operation.
+//
+// Report whether the passkeys of the user of the login session are backed up or only device-bound.
+//
+// GET /backup-status
+func (UnimplementedHandler) GetBackupStatus(ctx context.Context) (r GetBackupStatusRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMe implements getMe operation.
+//
+// Get the user and the credential of the login session.
+//
+// GET /me
+func (UnimplementedHandler) GetMe(ctx context.Context) (r GetMeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetVaultKey implements getVaultKey operation.
+//
+// Get the vault key wrapped for the credential the login session was asserted with. The client
+// unwraps it with the prf output of that login.
+//
+// GET /vault/key
+func (UnimplementedHandler) GetVaultKey(ctx context.Context) (r GetVaultKeyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // InitializeAssertion implements initializeAssertion operation.
 //
 // Initialize Assertion.
 //
 // GET /assertion
-func (UnimplementedHandler) InitializeAssertion(ctx context.Context) (r InitializeAssertionRes, _ error) {
+func (UnimplementedHandler) InitializeAssertion(ctx context.Context, params InitializeAssertionParams) (r InitializeAssertionRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -45,17 +103,62 @@ func (UnimplementedHandler) InitializeAssertion(ctx context.Context) (r Initiali
 // Initialize Attestation.
 //
 // GET /attestation
-func (UnimplementedHandler) InitializeAttestation(ctx context.Context) (r InitializeAttestationRes, _ error) {
+func (UnimplementedHandler) InitializeAttestation(ctx context.Context, params InitializeAttestationParams) (r InitializeAttestationRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// InspectAttestation implements inspectAttestation operation.
+//
+// Decode the attestation object and the client data kept with a credential. The login user must be
+// an admin.
+//
+// GET /admin/credentials/{credentialId}/attestation
+func (UnimplementedHandler) InspectAttestation(ctx context.Context, params InspectAttestationParams) (r InspectAttestationRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
-// InitializeAttestationJSON implements initializeAttestationJSON operation.
+// ListAuditEvents implements listAuditEvents operation.
+//
+// List the audit events of the user of the login session, newest first.
+//
+// GET /audit
+func (UnimplementedHandler) ListAuditEvents(ctx context.Context) (r ListAuditEventsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListCredentials implements listCredentials operation.
+//
+// List the credentials of the user of the login session in creation order.
 //
-// Initialize Attestation JSON.
+// GET /credentials
+func (UnimplementedHandler) ListCredentials(ctx context.Context) (r ListCredentialsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// Logout implements logout operation.
+//
+// Logout.
+//
+// POST /logout
+func (UnimplementedHandler) Logout(ctx context.Context) (r *LogoutNoContent, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PutVaultKey implements putVaultKey operation.
+//
+// Store the vault key wrapped for the credential the login session was asserted with. Every
+// credential of the user must wrap the same key.
+//
+// PUT /vault/key
+func (UnimplementedHandler) PutVaultKey(ctx context.Context, req *PutVaultKeyRequest) (r PutVaultKeyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RenameCredential implements renameCredential operation.
 //
-// Deprecated: schema marks this operation as deprecated.
+// Rename a credential of the user of the login session.
 //
-// GET /attestation/json
-func (UnimplementedHandler) InitializeAttestationJSON(ctx context.Context) (r InitializeAttestationJSONRes, _ error) {
+// PATCH /credentials/{credentialId}
+func (UnimplementedHandler) RenameCredential(ctx context.Context, req *RenameCredentialRequest, params RenameCredentialParams) (r RenameCredentialRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
@@ -0,0 +1,132 @@
+// Package audit records security relevant events of user accounts.
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Type is the kind of an event.
+type Type string
+
+const (
+	TypeCredentialRegistered Type = "credential_registered"
+	TypeRegistrationRejected Type = "registration_rejected"
+	TypeCredentialDeleted    Type = "credential_deleted"
+	TypeLogin                Type = "login"
+	TypeLoginRejected        Type = "login_rejected"
+	TypeCloneWarning         Type = "clone_warning"
+	TypeBackupStateChanged   Type = "backup_state_changed"
+	TypeVaultKeyWrapped      Type = "vault_key_wrapped"
+	TypeVaultDiscarded       Type = "vault_discarded"
+)
+
+// Event is an entry of the audit trail.
+type Event struct {
+	Time         time.Time `json:"time"`
+	Type         Type      `json:"type"`
+	UserID       []byte    `json:"userId,omitempty"`
+	CredentialID []byte    `json:"credentialId,omitempty"`
+	Detail       string    `json:"detail,omitempty"`
+}
+
+// Trail appends events to a JSON Lines file and keeps them in memory to list them.
+type Trail struct {
+	mu     sync.RWMutex
+	path   string
+	events []Event
+}
+
+// Open opens the trail at path, loading the events written by a previous run.
+func Open(path string) (*Trail, error) {
+	tr := &Trail{
+		path: path,
+	}
+
+	f, err := os.Open(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return tr, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to open %s. error: %w", path, err)
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+
+	for sc.Scan() {
+		var ev Event
+
+		if err := json.Unmarshal(sc.Bytes(), &ev); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s. error: %w", path, err)
+		}
+
+		tr.events = append(tr.events, ev)
+	}
+
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s. error: %w", path, err)
+	}
+
+	return tr, nil
+}
+
+// Record appends the event to the trail. Time is set to now if it is zero.
+func (tr *Trail) Record(ctx context.Context, ev Event) error {
+	if ev.Time.IsZero() {
+		ev.Time = time.Now()
+	}
+
+	line, err := json.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event. error: %w", err)
+	}
+
+	slog.InfoContext(ctx, "audit", slog.String("event", string(line)))
+
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(tr.path), 0o700); err != nil {
+		return fmt.Errorf("failed to create directory. error: %w", err)
+	}
+
+	f, err := os.OpenFile(tr.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open %s. error: %w", tr.path, err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write %s. error: %w", tr.path, err)
+	}
+
+	tr.events = append(tr.events, ev)
+
+	return nil
+}
+
+// List returns the events of the user, newest first.
+func (tr *Trail) List(ctx context.Context, userID []byte) []Event {
+	tr.mu.RLock()
+	defer tr.mu.RUnlock()
+
+	events := make([]Event, 0)
+
+	for i := len(tr.events) - 1; i >= 0; i-- {
+		if bytes.Equal(tr.events[i].UserID, userID) {
+			events = append(events, tr.events[i])
+		}
+	}
+
+	return events
+}
@@ -0,0 +1,215 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ogen-go/ogen/middleware"
+)
+
+// CookieName is the name of the cookie holding the login session.
+const CookieName = "login_session"
+
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// Session is an application session created after a successful assertion.
+type Session struct {
+	ID           string
+	UserID       []byte
+	CredentialID []byte
+	CreatedAt    time.Time
+	LastSeenAt   time.Time
+	ExpiresAt    time.Time
+}
+
+// Manager issues login sessions and keeps them in memory.
+// The cookie only carries the session id signed with a key generated at startup,
+// so every session is invalidated when the server restarts.
+type Manager struct {
+	key             []byte
+	idleTimeout     time.Duration
+	absoluteTimeout time.Duration
+
+	mu       sync.Mutex
+	sessions map[string]Session
+}
+
+// NewManager returns a Manager. A session expires when it is not used for idleTimeout
+// or when absoluteTimeout has passed since the login, whichever comes first.
+func NewManager(idleTimeout time.Duration, absoluteTimeout time.Duration) (*Manager, error) {
+	key := make([]byte, sha256.Size)
+
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, fmt.Errorf("failed to read random. error: %w", err)
+	}
+
+	return &Manager{
+		key:             key,
+		idleTimeout:     idleTimeout,
+		absoluteTimeout: absoluteTimeout,
+		sessions:        map[string]Session{},
+	}, nil
+}
+
+// Login creates a session for the user and returns the cookie to set.
+func (mgr *Manager) Login(userID []byte, credentialID []byte) (*http.Cookie, error) {
+	buf := make([]byte, 32)
+
+	if _, err := io.ReadFull(rand.Reader, buf); err != nil {
+		return nil, fmt.Errorf("failed to read random. error: %w", err)
+	}
+
+	id := base64.RawURLEncoding.EncodeToString(buf)
+
+	now := time.Now()
+
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	// NOTE: ログインのたびに期限切れのセッションを掃除する
+	for key, ss := range mgr.sessions {
+		if mgr.expired(ss, now) {
+			delete(mgr.sessions, key)
+		}
+	}
+
+	mgr.sessions[id] = Session{
+		ID:           id,
+		UserID:       userID,
+		CredentialID: credentialID,
+		CreatedAt:    now,
+		LastSeenAt:   now,
+	}
+
+	// NOTE: フロントエンドと API は同じサイトなので、他のサイトからのリクエストにはログインの cookie を付けさせない
+	return &http.Cookie{
+		Name:     CookieName,
+		Value:    id + "." + mgr.sign(id),
+		Path:     "/",
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+		MaxAge:   int(mgr.absoluteTimeout.Seconds()),
+	}, nil
+}
+
+// Authenticate verifies the cookie value and returns the session it refers to.
+// Using a session extends its idle expiry.
+func (mgr *Manager) Authenticate(value string) (*Session, error) {
+	id, signature, ok := strings.Cut(value, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(mgr.sign(id))) {
+		return nil, ErrUnauthenticated
+	}
+
+	now := time.Now()
+
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	ss, ok := mgr.sessions[id]
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+
+	if mgr.expired(ss, now) {
+		delete(mgr.sessions, id)
+
+		return nil, ErrUnauthenticated
+	}
+
+	ss.LastSeenAt = now
+
+	mgr.sessions[id] = ss
+
+	ss.ExpiresAt = mgr.expiresAt(ss)
+
+	return &ss, nil
+}
+
+// Logout deletes the session and returns the cookie that removes it from the browser.
+func (mgr *Manager) Logout(id string) *http.Cookie {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	delete(mgr.sessions, id)
+
+	return &http.Cookie{
+		Name:   CookieName,
+		Value:  "",
+		Path:   "/",
+		MaxAge: -1,
+	}
+}
+
+// RevokeCredential deletes every session logged in with the credential.
+func (mgr *Manager) RevokeCredential(credentialID []byte) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	for id, ss := range mgr.sessions {
+		if bytes.Equal(ss.CredentialID, credentialID) {
+			delete(mgr.sessions, id)
+		}
+	}
+}
+
+// Middleware injects the session of the request into the context. Requests without a valid session are passed
+// through unchanged so that each operation decides whether it requires a login.
+func (mgr *Manager) Middleware(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+	if cookie, err := req.Raw.Cookie(CookieName); err == nil {
+		if ss, err := mgr.Authenticate(cookie.Value); err == nil {
+			req.Context = WithSession(req.Context, ss)
+		}
+	}
+
+	return next(req)
+}
+
+func (mgr *Manager) sign(id string) string {
+	mac := hmac.New(sha256.New, mgr.key)
+
+	mac.Write([]byte(id))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (mgr *Manager) expiresAt(ss Session) time.Time {
+	idle := ss.LastSeenAt.Add(mgr.idleTimeout)
+
+	absolute := ss.CreatedAt.Add(mgr.absoluteTimeout)
+
+	if idle.Before(absolute) {
+		return idle
+	}
+
+	return absolute
+}
+
+func (mgr *Manager) expired(ss Session, now time.Time) bool {
+	return !now.Before(mgr.expiresAt(ss))
+}
+
+type sessionKey struct{}
+
+// WithSession returns a context carrying the session.
+func WithSession(ctx context.Context, ss *Session) context.Context {
+	return context.WithValue(ctx, sessionKey{}, ss)
+}
+
+// FromContext returns the session injected by Middleware.
+func FromContext(ctx context.Context) (*Session, bool) {
+	ss, ok := ctx.Value(sessionKey{}).(*Session)
+
+	return ss, ok
+}
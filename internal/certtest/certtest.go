@@ -0,0 +1,85 @@
+// Package certtest issues certificates with ECDSA P-256 keys to build the certificate chains of tests.
+package certtest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// Cert is a certificate and its private key.
+type Cert struct {
+	Certificate *x509.Certificate
+	Key         *ecdsa.PrivateKey
+}
+
+// New returns a self-signed certificate made from the template, such as a root.
+func New(tmpl *x509.Certificate) (*Cert, error) {
+	return issue(tmpl, nil)
+}
+
+// Issue returns a certificate made from the template and signed by the certificate.
+func (c *Cert) Issue(tmpl *x509.Certificate) (*Cert, error) {
+	return issue(tmpl, c)
+}
+
+// issue fills the serial number, the validity and the key usage left empty in the template. Without a parent the
+// certificate signs itself.
+func issue(tmpl *x509.Certificate, parent *Cert) (*Cert, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate key. error: %w", err)
+	}
+
+	cert := *tmpl
+
+	if cert.SerialNumber == nil {
+		cert.SerialNumber, err = rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 64))
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate serial number. error: %w", err)
+		}
+	}
+
+	if cert.NotBefore.IsZero() {
+		cert.NotBefore = time.Now().Add(-time.Hour)
+	}
+
+	if cert.NotAfter.IsZero() {
+		cert.NotAfter = time.Now().Add(time.Hour)
+	}
+
+	if cert.KeyUsage == 0 {
+		cert.KeyUsage = x509.KeyUsageDigitalSignature
+
+		if cert.IsCA {
+			cert.KeyUsage |= x509.KeyUsageCertSign
+		}
+	}
+
+	cert.BasicConstraintsValid = true
+
+	signer, signerKey := &cert, key
+
+	if parent != nil {
+		signer, signerKey = parent.Certificate, parent.Key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &cert, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create certificate. error: %w", err)
+	}
+
+	parsed, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate. error: %w", err)
+	}
+
+	return &Cert{
+		Certificate: parsed,
+		Key:         key,
+	}, nil
+}
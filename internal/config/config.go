@@ -0,0 +1,767 @@
+// Package config loads the settings of the server.
+//
+// Settings are resolved in the following order, later sources overriding earlier ones:
+// the defaults, the config file (YAML or JSON), the environment variables and the command line flags.
+package config
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/go-webauthn/webauthn/metadata"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/extension"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/keyring"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/policy"
+)
+
+// Config is the settings of the server.
+type Config struct {
+	// Addr is the address the server listens on.
+	Addr string `json:"addr"`
+
+	// StorePath is the path of the file credentials are stored in.
+	StorePath string `json:"storePath"`
+
+	// AuditPath is the path of the file the audit trail is appended to.
+	AuditPath string `json:"auditPath"`
+
+	WebAuthn WebAuthn `json:"webauthn"`
+	CORS     CORS     `json:"cors"`
+	Session  Session  `json:"session"`
+	Login    Login    `json:"login"`
+	Policy   Policy   `json:"policy"`
+	Metadata Metadata `json:"metadata"`
+	Admin    Admin    `json:"admin"`
+}
+
+// WebAuthn is the settings of the relying party. It mirrors webauthn.Config.
+type WebAuthn struct {
+	RPID                   string                 `json:"rpId"`
+	RPDisplayName          string                 `json:"rpDisplayName"`
+	RPOrigins              []string               `json:"rpOrigins"`
+	AttestationPreference  string                 `json:"attestationPreference"`
+	AuthenticatorSelection AuthenticatorSelection `json:"authenticatorSelection"`
+	Debug                  bool                   `json:"debug"`
+	EncodeUserIDAsString   bool                   `json:"encodeUserIdAsString"`
+	Timeouts               Timeouts               `json:"timeouts"`
+	Extensions             Extensions             `json:"extensions"`
+}
+
+// Extensions is the WebAuthn extensions requested by the ceremonies.
+type Extensions struct {
+	// CredProps asks whether a new credential is discoverable.
+	CredProps bool `json:"credProps"`
+
+	// CredProtect is the credential protection policy requested at registration: userVerificationOptional,
+	// userVerificationOptionalWithCredentialIDList or userVerificationRequired. Empty requests none.
+	CredProtect        string `json:"credProtect"`
+	EnforceCredProtect bool   `json:"enforceCredProtect"`
+
+	// MinPinLength asks the authenticator for its minimum PIN length.
+	MinPinLength bool `json:"minPinLength"`
+
+	// LargeBlob is the large blob support requested at registration, preferred or required. Empty requests none.
+	LargeBlob string `json:"largeBlob"`
+	// LargeBlobRead reads the large blob at login.
+	LargeBlobRead bool `json:"largeBlobRead"`
+
+	// PRF asks whether a new credential supports prf and evaluates it with the salt of the credential at login.
+	PRF bool `json:"prf"`
+}
+
+// AuthenticatorSelection is the default authenticator selection criteria of registrations.
+type AuthenticatorSelection struct {
+	// AuthenticatorAttachment is platform or cross-platform. Empty allows both.
+	AuthenticatorAttachment string `json:"authenticatorAttachment"`
+	ResidentKey             string `json:"residentKey"`
+	UserVerification        string `json:"userVerification"`
+}
+
+// Timeouts is the timeouts of the ceremonies.
+type Timeouts struct {
+	Login        Timeout `json:"login"`
+	Registration Timeout `json:"registration"`
+}
+
+// Timeout is the timeout of a ceremony. Zero uses the default of the webauthn package.
+type Timeout struct {
+	Enforce    bool     `json:"enforce"`
+	Timeout    Duration `json:"timeout"`
+	TimeoutUVD Duration `json:"timeoutUvd"`
+}
+
+// CORS is the cross-origin resource sharing policy.
+type CORS struct {
+	// AllowedOrigins are the origins allowed to send requests with the login cookie. Empty allows webauthn.rpOrigins.
+	AllowedOrigins []string `json:"allowedOrigins"`
+}
+
+// Session is the settings of the ceremony session.
+type Session struct {
+	// Keys is comma separated "<key id>:<base64 encoded secret>" pairs sealing the session cookie, newest first.
+	// A random key is used when empty.
+	Keys string `json:"keys"`
+
+	// Store is where the session is kept. cookie, memory or redis.
+	Store string `json:"store"`
+
+	RedisAddr string `json:"redisAddr"`
+}
+
+// Login is the settings of the login session.
+type Login struct {
+	IdleTimeout     Duration `json:"idleTimeout"`
+	AbsoluteTimeout Duration `json:"absoluteTimeout"`
+}
+
+// Policy is how the server reacts to suspicious credentials.
+type Policy struct {
+	// CloneWarning is the reaction to a signature counter which did not increase, a sign of a cloned authenticator.
+	// log only records it, flag also marks the credential and reject also refuses logins with the marked credential.
+	CloneWarning string `json:"cloneWarning"`
+
+	Attestation AttestationPolicy `json:"attestation"`
+}
+
+// AttestationPolicy is which authenticators may be registered. Empty settings allow anything.
+type AttestationPolicy struct {
+	AllowedFormats []string `json:"allowedFormats"`
+	DeniedFormats  []string `json:"deniedFormats"`
+	AllowedAAGUIDs []string `json:"allowedAaguids"`
+	DeniedAAGUIDs  []string `json:"deniedAaguids"`
+
+	// MinCertificationLevel is the lowest FIDO certification status accepted, such as FIDO_CERTIFIED_L1.
+	// It requires the FIDO Metadata Service.
+	MinCertificationLevel string `json:"minCertificationLevel"`
+
+	// RejectUndesiredStatus refuses authenticators whose status in the FIDO Metadata Service is undesired.
+	RejectUndesiredStatus bool `json:"rejectUndesiredStatus"`
+
+	// TrustStorePath is a directory of PEM files of the roots attestation certificate chains are verified against.
+	TrustStorePath string `json:"trustStorePath"`
+
+	// RequireTrustAnchor refuses authenticators whose attestation is not validated by a root of the trust store.
+	RequireTrustAnchor bool `json:"requireTrustAnchor"`
+
+	// EnterpriseRPIDs are the RP IDs permitted to request enterprise attestation.
+	EnterpriseRPIDs []string `json:"enterpriseRpIds"`
+
+	// EnterpriseInventoryPath is a file of the serial numbers or fingerprints of the approved devices. Only the
+	// devices identified by enterprise attestation as one of them may be registered. It requires TrustStorePath and
+	// RequireTrustAnchor, as anyone can issue a self-signed certificate with an approved serial number.
+	EnterpriseInventoryPath string `json:"enterpriseInventoryPath"`
+}
+
+// Metadata is the sources describing authenticator models.
+type Metadata struct {
+	// AAGUIDPath is a JSON file in the format of the community list of passkey provider AAGUIDs. Its entries are
+	// added to the bundled dataset, which has no icons.
+	AAGUIDPath string `json:"aaguidPath"`
+	// MDSSource is the FIDO Metadata Service BLOB, a file path or an http(s) URL. Empty disables it.
+	MDSSource string `json:"mdsSource"`
+	// MDSRootPath is a PEM file of the root certificates the BLOB signing chain must end in.
+	MDSRootPath string `json:"mdsRootPath"`
+	// MDSRefreshInterval is how often the BLOB is reloaded.
+	MDSRefreshInterval Duration `json:"mdsRefreshInterval"`
+}
+
+// Admin is the settings of the admin operations.
+type Admin struct {
+	// UserIDs are the user handles in base64url of the users allowed to call the admin operations. User names are
+	// not used as anyone can register with any free name.
+	UserIDs []string `json:"userIds"`
+}
+
+// Duration is a time.Duration written as a string such as "5m" in the config file.
+type Duration time.Duration
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Duration) UnmarshalText(text []byte) error {
+	v, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = Duration(v)
+
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+// Default returns the settings used when nothing is configured.
+func Default() Config {
+	return Config{
+		Addr:      ":8080",
+		StorePath: "data/store.json",
+		AuditPath: "data/audit.jsonl",
+		WebAuthn: WebAuthn{
+			RPID:                  "localhost",
+			RPDisplayName:         "passkey",
+			RPOrigins:             []string{"http://localhost:5500"},
+			AttestationPreference: string(protocol.PreferDirectAttestation),
+			AuthenticatorSelection: AuthenticatorSelection{
+				ResidentKey:      string(protocol.ResidentKeyRequirementRequired),
+				UserVerification: string(protocol.VerificationPreferred),
+			},
+			Extensions: Extensions{
+				CredProps: true,
+			},
+		},
+		Session: Session{
+			Store:     "cookie",
+			RedisAddr: "localhost:6379",
+		},
+		Login: Login{
+			IdleTimeout:     Duration(30 * time.Minute),
+			AbsoluteTimeout: Duration(24 * time.Hour),
+		},
+		Policy: Policy{
+			CloneWarning: "flag",
+		},
+		Metadata: Metadata{
+			MDSRefreshInterval: Duration(24 * time.Hour),
+		},
+	}
+}
+
+// Load resolves the settings from the config file, the environment variables and the command line arguments
+// and validates them. The config file is given by -config or CONFIG_FILE.
+func Load(args []string) (Config, error) {
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+
+	path := fs.String("config", os.Getenv("CONFIG_FILE"), "path of the config file (YAML or JSON). env: CONFIG_FILE")
+
+	raw := make(map[string]string, len(options))
+
+	for _, opt := range options {
+		opt := opt
+
+		usage := fmt.Sprintf("%s. env: %s", opt.usage, opt.env)
+
+		if opt.boolean {
+			fs.BoolFunc(opt.flag, usage, func(value string) error {
+				raw[opt.flag] = value
+				return nil
+			})
+
+			continue
+		}
+
+		fs.Func(opt.flag, usage, func(value string) error {
+			raw[opt.flag] = value
+			return nil
+		})
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return Config{}, err
+	}
+
+	cfg := Default()
+
+	if *path != "" {
+		if err := loadFile(*path, &cfg); err != nil {
+			return Config{}, err
+		}
+	}
+
+	for _, opt := range options {
+		// NOTE: Makefile は .env の空の変数もエクスポートするので、空は未設定として扱う
+		value := os.Getenv(opt.env)
+		if value == "" {
+			continue
+		}
+
+		if err := opt.set(&cfg, value); err != nil {
+			return Config{}, fmt.Errorf("invalid environment variable %s=%q. error: %w", opt.env, value, err)
+		}
+	}
+
+	// NOTE: 指定されたフラグだけで上書きするので、未指定のフラグのデフォルト値で環境変数を潰さない
+	for _, opt := range options {
+		value, ok := raw[opt.flag]
+		if !ok {
+			continue
+		}
+
+		if err := opt.set(&cfg, value); err != nil {
+			return Config{}, fmt.Errorf("invalid flag -%s=%q. error: %w", opt.flag, value, err)
+		}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+// loadFile overrides cfg with the fields present in the config file. JSON is read as YAML.
+func loadFile(path string, cfg *Config) error {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file. error: %w", err)
+	}
+
+	jsonBuf, err := yaml.YAMLToJSON(buf)
+	if err != nil {
+		return fmt.Errorf("failed to parse config file %s. error: %w", path, err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(jsonBuf))
+
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(cfg); err != nil {
+		return fmt.Errorf("failed to decode config file %s. error: %w", path, err)
+	}
+
+	return nil
+}
+
+// option is a setting which can be given by an environment variable and a flag.
+type option struct {
+	env   string
+	flag  string
+	usage string
+	set   func(cfg *Config, value string) error
+
+	// boolean lets the flag be given without a value.
+	boolean bool
+}
+
+func stringOption(env, flag, usage string, field func(*Config) *string) option {
+	return option{env: env, flag: flag, usage: usage, set: setString(field)}
+}
+
+func listOption(env, flag, usage string, field func(*Config) *[]string) option {
+	return option{env: env, flag: flag, usage: usage, set: setList(field)}
+}
+
+func durationOption(env, flag, usage string, field func(*Config) *Duration) option {
+	return option{env: env, flag: flag, usage: usage, set: setDuration(field)}
+}
+
+func boolOption(env, flag, usage string, field func(*Config) *bool) option {
+	return option{env: env, flag: flag, usage: usage, set: setBool(field), boolean: true}
+}
+
+var options = []option{
+	stringOption("LISTEN_ADDR", "addr", "address the server listens on", func(c *Config) *string { return &c.Addr }),
+	stringOption("STORE_PATH", "store-path", "path of the credential store file", func(c *Config) *string { return &c.StorePath }),
+	stringOption("AUDIT_PATH", "audit-path", "path of the audit trail file", func(c *Config) *string { return &c.AuditPath }),
+	stringOption("RP_ID", "rp-id", "relying party id", func(c *Config) *string { return &c.WebAuthn.RPID }),
+	stringOption("RP_DISPLAY_NAME", "rp-display-name", "relying party display name", func(c *Config) *string { return &c.WebAuthn.RPDisplayName }),
+	listOption("RP_ORIGINS", "rp-origins", "comma separated origins allowed to run ceremonies", func(c *Config) *[]string { return &c.WebAuthn.RPOrigins }),
+	stringOption("ATTESTATION_PREFERENCE", "attestation-preference", "none, indirect, direct or enterprise", func(c *Config) *string { return &c.WebAuthn.AttestationPreference }),
+	stringOption("AUTHENTICATOR_ATTACHMENT", "authenticator-attachment", "platform or cross-platform. empty allows both", func(c *Config) *string { return &c.WebAuthn.AuthenticatorSelection.AuthenticatorAttachment }),
+	stringOption("RESIDENT_KEY", "resident-key", "discouraged, preferred or required", func(c *Config) *string { return &c.WebAuthn.AuthenticatorSelection.ResidentKey }),
+	stringOption("USER_VERIFICATION", "user-verification", "discouraged, preferred or required", func(c *Config) *string { return &c.WebAuthn.AuthenticatorSelection.UserVerification }),
+	boolOption("WEBAUTHN_DEBUG", "webauthn-debug", "enable the debug options of the webauthn package", func(c *Config) *bool { return &c.WebAuthn.Debug }),
+	boolOption("EXTENSION_CRED_PROPS", "extension-cred-props", "request the credProps extension at registration", func(c *Config) *bool { return &c.WebAuthn.Extensions.CredProps }),
+	stringOption("EXTENSION_CRED_PROTECT", "extension-cred-protect", "credential protection policy requested by the credProtect extension", func(c *Config) *string { return &c.WebAuthn.Extensions.CredProtect }),
+	boolOption("EXTENSION_ENFORCE_CRED_PROTECT", "extension-enforce-cred-protect", "fail the registration when credProtect cannot be applied", func(c *Config) *bool { return &c.WebAuthn.Extensions.EnforceCredProtect }),
+	boolOption("EXTENSION_MIN_PIN_LENGTH", "extension-min-pin-length", "request the minPinLength extension at registration", func(c *Config) *bool { return &c.WebAuthn.Extensions.MinPinLength }),
+	stringOption("EXTENSION_LARGE_BLOB", "extension-large-blob", "large blob support requested at registration. preferred or required", func(c *Config) *string { return &c.WebAuthn.Extensions.LargeBlob }),
+	boolOption("EXTENSION_LARGE_BLOB_READ", "extension-large-blob-read", "read the large blob at login", func(c *Config) *bool { return &c.WebAuthn.Extensions.LargeBlobRead }),
+	boolOption("EXTENSION_PRF", "extension-prf", "request the prf extension and evaluate it with per-credential salts at login", func(c *Config) *bool { return &c.WebAuthn.Extensions.PRF }),
+	boolOption("ENCODE_USER_ID_AS_STRING", "encode-user-id-as-string", "encode user.id as a raw string instead of base64url", func(c *Config) *bool { return &c.WebAuthn.EncodeUserIDAsString }),
+	boolOption("LOGIN_TIMEOUT_ENFORCE", "login-timeout-enforce", "enforce the login timeout on the server", func(c *Config) *bool { return &c.WebAuthn.Timeouts.Login.Enforce }),
+	durationOption("LOGIN_TIMEOUT", "login-timeout", "login timeout", func(c *Config) *Duration { return &c.WebAuthn.Timeouts.Login.Timeout }),
+	durationOption("LOGIN_TIMEOUT_UVD", "login-timeout-uvd", "login timeout when user verification is discouraged", func(c *Config) *Duration { return &c.WebAuthn.Timeouts.Login.TimeoutUVD }),
+	boolOption("REGISTRATION_TIMEOUT_ENFORCE", "registration-timeout-enforce", "enforce the registration timeout on the server", func(c *Config) *bool { return &c.WebAuthn.Timeouts.Registration.Enforce }),
+	durationOption("REGISTRATION_TIMEOUT", "registration-timeout", "registration timeout", func(c *Config) *Duration { return &c.WebAuthn.Timeouts.Registration.Timeout }),
+	durationOption("REGISTRATION_TIMEOUT_UVD", "registration-timeout-uvd", "registration timeout when user verification is discouraged", func(c *Config) *Duration { return &c.WebAuthn.Timeouts.Registration.TimeoutUVD }),
+	listOption("CORS_ALLOWED_ORIGINS", "cors-allowed-origins", "comma separated origins allowed by CORS. empty allows the rp origins", func(c *Config) *[]string { return &c.CORS.AllowedOrigins }),
+	stringOption("SESSION_KEYS", "session-keys", "comma separated <key id>:<base64 secret> sealing the session cookie, newest first", func(c *Config) *string { return &c.Session.Keys }),
+	stringOption("SESSION_STORE", "session-store", "cookie, memory or redis", func(c *Config) *string { return &c.Session.Store }),
+	stringOption("REDIS_ADDR", "redis-addr", "address of redis", func(c *Config) *string { return &c.Session.RedisAddr }),
+	durationOption("LOGIN_IDLE_TIMEOUT", "login-idle-timeout", "how long a login session stays valid without requests", func(c *Config) *Duration { return &c.Login.IdleTimeout }),
+	durationOption("LOGIN_ABSOLUTE_TIMEOUT", "login-absolute-timeout", "how long a login session stays valid at most", func(c *Config) *Duration { return &c.Login.AbsoluteTimeout }),
+	stringOption("AAGUID_PATH", "aaguid-path", "JSON file of the community list of passkey provider AAGUIDs", func(c *Config) *string { return &c.Metadata.AAGUIDPath }),
+	stringOption("MDS_SOURCE", "mds-source", "FIDO Metadata Service BLOB file or URL", func(c *Config) *string { return &c.Metadata.MDSSource }),
+	stringOption("MDS_ROOT_PATH", "mds-root-path", "PEM file of the root certificates of the metadata BLOB", func(c *Config) *string { return &c.Metadata.MDSRootPath }),
+	durationOption("MDS_REFRESH_INTERVAL", "mds-refresh-interval", "how often the metadata BLOB is reloaded", func(c *Config) *Duration { return &c.Metadata.MDSRefreshInterval }),
+	listOption("ADMIN_USER_IDS", "admin-user-ids", "comma separated base64url user handles of the users allowed to call the admin operations", func(c *Config) *[]string { return &c.Admin.UserIDs }),
+	stringOption("CLONE_WARNING_POLICY", "clone-warning-policy", "log, flag or reject a credential whose signature counter did not increase", func(c *Config) *string { return &c.Policy.CloneWarning }),
+	listOption("ATTESTATION_ALLOWED_FORMATS", "attestation-allowed-formats", "comma separated attestation formats allowed at registration", func(c *Config) *[]string { return &c.Policy.Attestation.AllowedFormats }),
+	listOption("ATTESTATION_DENIED_FORMATS", "attestation-denied-formats", "comma separated attestation formats denied at registration", func(c *Config) *[]string { return &c.Policy.Attestation.DeniedFormats }),
+	listOption("ATTESTATION_ALLOWED_AAGUIDS", "attestation-allowed-aaguids", "comma separated AAGUIDs allowed at registration", func(c *Config) *[]string { return &c.Policy.Attestation.AllowedAAGUIDs }),
+	listOption("ATTESTATION_DENIED_AAGUIDS", "attestation-denied-aaguids", "comma separated AAGUIDs denied at registration", func(c *Config) *[]string { return &c.Policy.Attestation.DeniedAAGUIDs }),
+	stringOption("ATTESTATION_MIN_CERTIFICATION_LEVEL", "attestation-min-certification-level", "lowest FIDO certification status accepted at registration such as FIDO_CERTIFIED_L1", func(c *Config) *string { return &c.Policy.Attestation.MinCertificationLevel }),
+	boolOption("ATTESTATION_REJECT_UNDESIRED_STATUS", "attestation-reject-undesired-status", "refuse authenticators whose metadata status is undesired", func(c *Config) *bool { return &c.Policy.Attestation.RejectUndesiredStatus }),
+	stringOption("ATTESTATION_TRUST_STORE_PATH", "attestation-trust-store-path", "directory of PEM roots attestation certificate chains are verified against", func(c *Config) *string { return &c.Policy.Attestation.TrustStorePath }),
+	boolOption("ATTESTATION_REQUIRE_TRUST_ANCHOR", "attestation-require-trust-anchor", "refuse authenticators whose attestation is not validated by the trust store", func(c *Config) *bool { return &c.Policy.Attestation.RequireTrustAnchor }),
+	listOption("ATTESTATION_ENTERPRISE_RP_IDS", "attestation-enterprise-rp-ids", "comma separated RP IDs permitted to request enterprise attestation", func(c *Config) *[]string { return &c.Policy.Attestation.EnterpriseRPIDs }),
+	stringOption("ATTESTATION_ENTERPRISE_INVENTORY_PATH", "attestation-enterprise-inventory-path", "file of the serial numbers or fingerprints of the approved devices", func(c *Config) *string { return &c.Policy.Attestation.EnterpriseInventoryPath }),
+}
+
+func setString(field func(*Config) *string) func(*Config, string) error {
+	return func(cfg *Config, value string) error {
+		*field(cfg) = value
+		return nil
+	}
+}
+
+func setList(field func(*Config) *[]string) func(*Config, string) error {
+	return func(cfg *Config, value string) error {
+		var list []string
+
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				list = append(list, v)
+			}
+		}
+
+		*field(cfg) = list
+
+		return nil
+	}
+}
+
+func setBool(field func(*Config) *bool) func(*Config, string) error {
+	return func(cfg *Config, value string) error {
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+
+		*field(cfg) = v
+
+		return nil
+	}
+}
+
+func setDuration(field func(*Config) *Duration) func(*Config, string) error {
+	return func(cfg *Config, value string) error {
+		return field(cfg).UnmarshalText([]byte(value))
+	}
+}
+
+// Validate reports every invalid setting at once.
+func (cfg Config) Validate() error {
+	var errs []error
+
+	invalid := func(field, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s: %s", field, fmt.Sprintf(format, args...)))
+	}
+
+	oneOf := func(field, value string, allowed ...string) {
+		if !slices.Contains(allowed, value) {
+			invalid(field, "%q must be one of %s", value, strings.Join(allowed, ", "))
+		}
+	}
+
+	if _, _, err := net.SplitHostPort(cfg.Addr); err != nil {
+		invalid("addr", "%q is not host:port. error: %s", cfg.Addr, err)
+	}
+
+	if cfg.StorePath == "" {
+		invalid("storePath", "must not be empty")
+	}
+
+	if cfg.AuditPath == "" {
+		invalid("auditPath", "must not be empty")
+	}
+
+	wa := cfg.WebAuthn
+
+	if wa.RPID == "" {
+		invalid("webauthn.rpId", "must not be empty")
+	} else if strings.ContainsAny(wa.RPID, ":/") {
+		invalid("webauthn.rpId", "%q must be a domain without scheme and port", wa.RPID)
+	}
+
+	if wa.RPDisplayName == "" {
+		invalid("webauthn.rpDisplayName", "must not be empty")
+	}
+
+	if len(wa.RPOrigins) == 0 {
+		invalid("webauthn.rpOrigins", "must not be empty")
+	}
+
+	for _, origin := range wa.RPOrigins {
+		u, err := url.Parse(origin)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || (u.Path != "" && u.Path != "/") {
+			invalid("webauthn.rpOrigins", "%q must be an origin such as https://example.com", origin)
+			continue
+		}
+
+		// NOTE: RP ID はオリジンのドメインそのものか、その上位ドメインでなければならない
+		if host := u.Hostname(); host != wa.RPID && !strings.HasSuffix(host, "."+wa.RPID) {
+			invalid("webauthn.rpOrigins", "%q is not within the rp id %q", origin, wa.RPID)
+		}
+	}
+
+	oneOf("webauthn.attestationPreference", wa.AttestationPreference,
+		string(protocol.PreferNoAttestation),
+		string(protocol.PreferIndirectAttestation),
+		string(protocol.PreferDirectAttestation),
+		string(protocol.PreferEnterpriseAttestation),
+	)
+
+	oneOf("webauthn.extensions.credProtect", wa.Extensions.CredProtect, append([]string{""}, extension.CredProtectPolicies...)...)
+
+	if wa.Extensions.EnforceCredProtect && wa.Extensions.CredProtect == "" {
+		invalid("webauthn.extensions.enforceCredProtect", "requires webauthn.extensions.credProtect")
+	}
+
+	oneOf("webauthn.extensions.largeBlob", wa.Extensions.LargeBlob, "", "preferred", "required")
+
+	sel := wa.AuthenticatorSelection
+
+	oneOf("webauthn.authenticatorSelection.authenticatorAttachment", sel.AuthenticatorAttachment,
+		"",
+		string(protocol.Platform),
+		string(protocol.CrossPlatform),
+	)
+
+	oneOf("webauthn.authenticatorSelection.residentKey", sel.ResidentKey,
+		string(protocol.ResidentKeyRequirementDiscouraged),
+		string(protocol.ResidentKeyRequirementPreferred),
+		string(protocol.ResidentKeyRequirementRequired),
+	)
+
+	oneOf("webauthn.authenticatorSelection.userVerification", sel.UserVerification,
+		string(protocol.VerificationDiscouraged),
+		string(protocol.VerificationPreferred),
+		string(protocol.VerificationRequired),
+	)
+
+	for _, timeout := range []struct {
+		field string
+		value Duration
+	}{
+		{"webauthn.timeouts.login.timeout", wa.Timeouts.Login.Timeout},
+		{"webauthn.timeouts.login.timeoutUvd", wa.Timeouts.Login.TimeoutUVD},
+		{"webauthn.timeouts.registration.timeout", wa.Timeouts.Registration.Timeout},
+		{"webauthn.timeouts.registration.timeoutUvd", wa.Timeouts.Registration.TimeoutUVD},
+	} {
+		if timeout.value < 0 {
+			invalid(timeout.field, "must not be negative")
+		}
+	}
+
+	oneOf("session.store", cfg.Session.Store, "cookie", "memory", "redis")
+
+	if cfg.Session.Store == "redis" && cfg.Session.RedisAddr == "" {
+		invalid("session.redisAddr", "must not be empty when session.store is redis")
+	}
+
+	if cfg.Session.Keys != "" {
+		if _, err := keyring.Parse(cfg.Session.Keys); err != nil {
+			invalid("session.keys", "%s", err)
+		}
+	}
+
+	if cfg.Login.IdleTimeout <= 0 {
+		invalid("login.idleTimeout", "must be positive")
+	}
+
+	if cfg.Login.AbsoluteTimeout < cfg.Login.IdleTimeout {
+		invalid("login.absoluteTimeout", "must not be shorter than login.idleTimeout")
+	}
+
+	oneOf("policy.cloneWarning", cfg.Policy.CloneWarning, "log", "flag", "reject")
+
+	att := cfg.Policy.Attestation
+
+	for _, formats := range []struct {
+		field  string
+		values []string
+	}{
+		{"policy.attestation.allowedFormats", att.AllowedFormats},
+		{"policy.attestation.deniedFormats", att.DeniedFormats},
+	} {
+		for _, format := range formats.values {
+			oneOf(formats.field, format, policy.Formats...)
+		}
+	}
+
+	for _, aaguids := range []struct {
+		field  string
+		values []string
+	}{
+		{"policy.attestation.allowedAaguids", att.AllowedAAGUIDs},
+		{"policy.attestation.deniedAaguids", att.DeniedAAGUIDs},
+	} {
+		for _, aaguid := range aaguids.values {
+			if _, err := uuid.Parse(aaguid); err != nil || len(aaguid) != 36 {
+				invalid(aaguids.field, "%q is not a UUID", aaguid)
+			}
+		}
+	}
+
+	if level := att.MinCertificationLevel; level != "" {
+		if !policy.IsCertificationLevel(metadata.AuthenticatorStatus(level)) {
+			invalid("policy.attestation.minCertificationLevel", "%q is not a certification level such as FIDO_CERTIFIED_L1", level)
+		}
+
+		if cfg.Metadata.MDSSource == "" {
+			invalid("policy.attestation.minCertificationLevel", "requires metadata.mdsSource")
+		}
+	}
+
+	if att.RejectUndesiredStatus && cfg.Metadata.MDSSource == "" {
+		invalid("policy.attestation.rejectUndesiredStatus", "requires metadata.mdsSource")
+	}
+
+	if att.RequireTrustAnchor && att.TrustStorePath == "" {
+		invalid("policy.attestation.requireTrustAnchor", "requires policy.attestation.trustStorePath")
+	}
+
+	enterprise := wa.AttestationPreference == string(protocol.PreferEnterpriseAttestation)
+
+	if enterprise && !slices.Contains(att.EnterpriseRPIDs, wa.RPID) {
+		invalid("webauthn.attestationPreference", "enterprise is not permitted for the rp id %q. add it to policy.attestation.enterpriseRpIds", wa.RPID)
+	}
+
+	if att.EnterpriseInventoryPath != "" && !enterprise {
+		invalid("policy.attestation.enterpriseInventoryPath", "requires webauthn.attestationPreference enterprise")
+	}
+
+	if att.EnterpriseInventoryPath != "" && (att.TrustStorePath == "" || !att.RequireTrustAnchor) {
+		invalid("policy.attestation.enterpriseInventoryPath", "requires policy.attestation.trustStorePath and policy.attestation.requireTrustAnchor")
+	}
+
+	if cfg.Metadata.MDSSource != "" && cfg.Metadata.MDSRootPath == "" {
+		invalid("metadata.mdsRootPath", "must not be empty when metadata.mdsSource is set")
+	}
+
+	if cfg.Metadata.MDSRefreshInterval <= 0 {
+		invalid("metadata.mdsRefreshInterval", "must be positive")
+	}
+
+	for _, id := range cfg.Admin.UserIDs {
+		if _, err := base64.RawURLEncoding.DecodeString(id); err != nil {
+			invalid("admin.userIds", "%q is not a base64url user handle", id)
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid config. error: %w", errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// AttestationPolicy returns the policy evaluated at registration.
+func (cfg Config) AttestationPolicy() policy.Attestation {
+	att := cfg.Policy.Attestation
+
+	lower := func(values []string) []string {
+		var out []string
+
+		for _, v := range values {
+			out = append(out, strings.ToLower(v))
+		}
+
+		return out
+	}
+
+	return policy.Attestation{
+		AllowedFormats:        att.AllowedFormats,
+		DeniedFormats:         att.DeniedFormats,
+		AllowedAAGUIDs:        lower(att.AllowedAAGUIDs),
+		DeniedAAGUIDs:         lower(att.DeniedAAGUIDs),
+		MinCertificationLevel: metadata.AuthenticatorStatus(att.MinCertificationLevel),
+		RejectUndesiredStatus: att.RejectUndesiredStatus,
+		RequireTrustAnchor:    att.RequireTrustAnchor,
+	}
+}
+
+// CORSAllowedOrigins returns the origins allowed by CORS, the rp origins unless configured.
+func (cfg Config) CORSAllowedOrigins() []string {
+	if len(cfg.CORS.AllowedOrigins) == 0 {
+		return cfg.WebAuthn.RPOrigins
+	}
+
+	return cfg.CORS.AllowedOrigins
+}
+
+// AdminUserIDs returns the user handles of the admins.
+func (cfg Config) AdminUserIDs() [][]byte {
+	var ids [][]byte
+
+	for _, id := range cfg.Admin.UserIDs {
+		if dec, err := base64.RawURLEncoding.DecodeString(id); err == nil {
+			ids = append(ids, dec)
+		}
+	}
+
+	return ids
+}
+
+// ExtensionConfig returns the WebAuthn extensions requested by the ceremonies.
+func (cfg Config) ExtensionConfig() extension.Config {
+	ext := cfg.WebAuthn.Extensions
+
+	return extension.Config{
+		CredProps:          ext.CredProps,
+		CredProtect:        ext.CredProtect,
+		EnforceCredProtect: ext.EnforceCredProtect,
+		MinPinLength:       ext.MinPinLength,
+		LargeBlob:          ext.LargeBlob,
+		LargeBlobRead:      ext.LargeBlobRead,
+		PRF:                ext.PRF,
+	}
+}
+
+// WebAuthnConfig returns the config of the webauthn package.
+func (cfg Config) WebAuthnConfig() *webauthn.Config {
+	wa := cfg.WebAuthn
+
+	residentKey := protocol.ResidentKeyRequirement(wa.AuthenticatorSelection.ResidentKey)
+
+	requireResidentKey := protocol.ResidentKeyNotRequired()
+	if residentKey == protocol.ResidentKeyRequirementRequired {
+		requireResidentKey = protocol.ResidentKeyRequired()
+	}
+
+	return &webauthn.Config{
+		RPID:                  wa.RPID,
+		RPDisplayName:         wa.RPDisplayName,
+		RPOrigins:             wa.RPOrigins,
+		AttestationPreference: protocol.ConveyancePreference(wa.AttestationPreference),
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			AuthenticatorAttachment: protocol.AuthenticatorAttachment(wa.AuthenticatorSelection.AuthenticatorAttachment),
+			RequireResidentKey:      requireResidentKey,
+			ResidentKey:             residentKey,
+			UserVerification:        protocol.UserVerificationRequirement(wa.AuthenticatorSelection.UserVerification),
+		},
+		Debug:                wa.Debug,
+		EncodeUserIDAsString: wa.EncodeUserIDAsString,
+		Timeouts: webauthn.TimeoutsConfig{
+			Login:        wa.Timeouts.Login.webauthn(),
+			Registration: wa.Timeouts.Registration.webauthn(),
+		},
+	}
+}
+
+func (t Timeout) webauthn() webauthn.TimeoutConfig {
+	return webauthn.TimeoutConfig{
+		Enforce:    t.Enforce,
+		Timeout:    time.Duration(t.Timeout),
+		TimeoutUVD: time.Duration(t.TimeoutUVD),
+	}
+}
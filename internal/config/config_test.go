@@ -0,0 +1,323 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+// clearEnv unsets every variable read by Load, as an empty variable is treated as unset.
+func clearEnv(t *testing.T) {
+	t.Helper()
+
+	t.Setenv("CONFIG_FILE", "")
+
+	for _, opt := range options {
+		t.Setenv(opt.env, "")
+	}
+}
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	tests := []struct {
+		name  string
+		file  string
+		env   map[string]string
+		args  []string
+		check func(t *testing.T, cfg Config)
+	}{
+		{
+			name: "defaults",
+			check: func(t *testing.T, cfg Config) {
+				if cfg.Addr != ":8080" {
+					t.Errorf("addr = %q, want the default", cfg.Addr)
+				}
+			},
+		},
+		{
+			name: "file overrides defaults",
+			file: "addr: \":9000\"\n",
+			check: func(t *testing.T, cfg Config) {
+				if cfg.Addr != ":9000" {
+					t.Errorf("addr = %q, want %q", cfg.Addr, ":9000")
+				}
+			},
+		},
+		{
+			name: "file keeps the defaults of missing fields",
+			file: "webauthn:\n  rpDisplayName: example\n",
+			check: func(t *testing.T, cfg Config) {
+				if cfg.WebAuthn.RPDisplayName != "example" {
+					t.Errorf("rpDisplayName = %q, want %q", cfg.WebAuthn.RPDisplayName, "example")
+				}
+
+				if cfg.WebAuthn.RPID != "localhost" || !cfg.WebAuthn.Extensions.CredProps {
+					t.Errorf("defaults of the other fields are lost. rpId: %q, credProps: %v", cfg.WebAuthn.RPID, cfg.WebAuthn.Extensions.CredProps)
+				}
+			},
+		},
+		{
+			name: "JSON file",
+			file: `{"login": {"idleTimeout": "10m"}}`,
+			check: func(t *testing.T, cfg Config) {
+				if cfg.Login.IdleTimeout != Duration(10*time.Minute) {
+					t.Errorf("idleTimeout = %s, want 10m", time.Duration(cfg.Login.IdleTimeout))
+				}
+			},
+		},
+		{
+			name: "environment variable overrides file",
+			file: "addr: \":9000\"\n",
+			env:  map[string]string{"LISTEN_ADDR": ":9100"},
+			check: func(t *testing.T, cfg Config) {
+				if cfg.Addr != ":9100" {
+					t.Errorf("addr = %q, want %q", cfg.Addr, ":9100")
+				}
+			},
+		},
+		{
+			name: "flag overrides environment variable",
+			file: "addr: \":9000\"\n",
+			env:  map[string]string{"LISTEN_ADDR": ":9100"},
+			args: []string{"-addr", ":9200"},
+			check: func(t *testing.T, cfg Config) {
+				if cfg.Addr != ":9200" {
+					t.Errorf("addr = %q, want %q", cfg.Addr, ":9200")
+				}
+			},
+		},
+		{
+			name: "empty environment variable is unset",
+			file: "addr: \":9000\"\n",
+			env:  map[string]string{"LISTEN_ADDR": ""},
+			check: func(t *testing.T, cfg Config) {
+				if cfg.Addr != ":9000" {
+					t.Errorf("addr = %q, want %q", cfg.Addr, ":9000")
+				}
+			},
+		},
+		{
+			name: "flags not given keep environment variables",
+			env:  map[string]string{"LISTEN_ADDR": ":9100"},
+			args: []string{"-rp-display-name", "example"},
+			check: func(t *testing.T, cfg Config) {
+				if cfg.Addr != ":9100" {
+					t.Errorf("addr = %q, want %q", cfg.Addr, ":9100")
+				}
+			},
+		},
+		{
+			name: "boolean flag without value",
+			env:  map[string]string{"EXTENSION_PRF": "false"},
+			args: []string{"-extension-prf"},
+			check: func(t *testing.T, cfg Config) {
+				if !cfg.WebAuthn.Extensions.PRF {
+					t.Error("prf = false, want true")
+				}
+			},
+		},
+		{
+			name: "boolean flag turns off a default",
+			args: []string{"-extension-cred-props=false"},
+			check: func(t *testing.T, cfg Config) {
+				if cfg.WebAuthn.Extensions.CredProps {
+					t.Error("credProps = true, want false")
+				}
+			},
+		},
+		{
+			name: "CORS allows the rp origins by default",
+			env:  map[string]string{"RP_ORIGINS": "http://localhost:3000"},
+			check: func(t *testing.T, cfg Config) {
+				want := []string{"http://localhost:3000"}
+
+				if got := cfg.CORSAllowedOrigins(); !slices.Equal(got, want) {
+					t.Errorf("CORS allowed origins = %v, want %v", got, want)
+				}
+			},
+		},
+		{
+			name: "CORS allowed origins override the rp origins",
+			env:  map[string]string{"CORS_ALLOWED_ORIGINS": "http://localhost:4000"},
+			check: func(t *testing.T, cfg Config) {
+				want := []string{"http://localhost:4000"}
+
+				if got := cfg.CORSAllowedOrigins(); !slices.Equal(got, want) {
+					t.Errorf("CORS allowed origins = %v, want %v", got, want)
+				}
+			},
+		},
+		{
+			name: "list environment variable",
+			env:  map[string]string{"RP_ORIGINS": "http://localhost:5500, ,http://localhost:3000"},
+			check: func(t *testing.T, cfg Config) {
+				want := []string{"http://localhost:5500", "http://localhost:3000"}
+
+				if !slices.Equal(cfg.WebAuthn.RPOrigins, want) {
+					t.Errorf("rpOrigins = %v, want %v", cfg.WebAuthn.RPOrigins, want)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			clearEnv(t)
+
+			args := tt.args
+
+			if tt.file != "" {
+				args = append([]string{"-config", writeFile(t, "config.yaml", tt.file)}, args...)
+			}
+
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			cfg, err := Load(args)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tt.check(t, cfg)
+		})
+	}
+}
+
+func TestLoadConfigFileFromEnvironment(t *testing.T) {
+	clearEnv(t)
+
+	t.Setenv("CONFIG_FILE", writeFile(t, "env.yaml", "addr: \":9000\"\n"))
+
+	cfg, err := Load(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.Addr != ":9000" {
+		t.Errorf("addr = %q, want the value of CONFIG_FILE", cfg.Addr)
+	}
+
+	cfg, err = Load([]string{"-config", writeFile(t, "flag.yaml", "addr: \":9100\"\n")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.Addr != ":9100" {
+		t.Errorf("addr = %q, want the value of -config", cfg.Addr)
+	}
+}
+
+func TestLoadError(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		env  map[string]string
+		args []string
+	}{
+		{
+			name: "unknown field in file",
+			file: "unknown: true\n",
+		},
+		{
+			name: "invalid environment variable",
+			env:  map[string]string{"EXTENSION_PRF": "maybe"},
+		},
+		{
+			name: "invalid flag",
+			args: []string{"-login-idle-timeout", "soon"},
+		},
+		{
+			name: "admin user id not in base64url",
+			env:  map[string]string{"ADMIN_USER_IDS": "alice+bob"},
+		},
+		{
+			name: "invalid after overriding",
+			file: "policy:\n  cloneWarning: log\n",
+			args: []string{"-clone-warning-policy", "ignore"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			clearEnv(t)
+
+			args := tt.args
+
+			if tt.file != "" {
+				args = append([]string{"-config", writeFile(t, "config.yaml", tt.file)}, args...)
+			}
+
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			if _, err := Load(args); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestValidateEnterpriseInventory(t *testing.T) {
+	tests := []struct {
+		name    string
+		change  func(cfg *Config)
+		wantErr bool
+	}{
+		{
+			name: "with trust anchor",
+			change: func(cfg *Config) {
+				cfg.Policy.Attestation.TrustStorePath = "trust"
+				cfg.Policy.Attestation.RequireTrustAnchor = true
+			},
+		},
+		{
+			name:    "without trust store",
+			change:  func(cfg *Config) {},
+			wantErr: true,
+		},
+		{
+			name: "trust anchor not required",
+			change: func(cfg *Config) {
+				cfg.Policy.Attestation.TrustStorePath = "trust"
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Default()
+
+			cfg.WebAuthn.AttestationPreference = "enterprise"
+			cfg.Policy.Attestation.EnterpriseRPIDs = []string{cfg.WebAuthn.RPID}
+			cfg.Policy.Attestation.EnterpriseInventoryPath = "inventory.txt"
+
+			tt.change(&cfg)
+
+			if err := cfg.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
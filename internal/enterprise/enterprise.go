@@ -0,0 +1,128 @@
+// Package enterprise identifies managed authenticators from their enterprise attestation and matches them against an
+// inventory of approved devices.
+//
+// With enterprise attestation the attestation certificate is unique to the device, so its serial number and
+// fingerprint identify it.
+package enterprise
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// Device is the identifiers of an authenticator taken from its attestation certificate. Both are lower case hex.
+type Device struct {
+	Serial      string
+	Fingerprint string
+}
+
+// Granted reports whether the authenticator granted enterprise attestation, which it signals by epAtt in the
+// attestation object. Otherwise the attestation certificate is shared by a batch of devices and identifies none.
+func Granted(attestationObject []byte) bool {
+	var obj struct {
+		EpAtt bool `cbor:"epAtt"`
+	}
+
+	if err := cbor.Unmarshal(attestationObject, &obj); err != nil {
+		return false
+	}
+
+	return obj.EpAtt
+}
+
+// Identify returns the identifiers of the leaf certificate of the x5c of an attestation statement.
+func Identify(statement map[string]interface{}) (*Device, error) {
+	x5c, ok := statement["x5c"].([]interface{})
+	if !ok || len(x5c) == 0 {
+		return nil, errors.New("attestation statement has no certificate")
+	}
+
+	der, ok := x5c[0].([]byte)
+	if !ok {
+		return nil, errors.New("x5c is not a list of certificates")
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse x5c. error: %w", err)
+	}
+
+	sum := sha256.Sum256(cert.Raw)
+
+	return &Device{
+		Serial:      hex.EncodeToString(cert.SerialNumber.Bytes()),
+		Fingerprint: hex.EncodeToString(sum[:]),
+	}, nil
+}
+
+// Inventory is the set of approved device identifiers.
+type Inventory struct {
+	ids map[string]struct{}
+}
+
+// LoadInventory reads the inventory at path: one serial number or SHA-256 fingerprint in hex per line. Case and
+// colons are ignored, and lines starting with # are comments.
+func LoadInventory(path string) (*Inventory, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s. error: %w", path, err)
+	}
+
+	inv := &Inventory{
+		ids: map[string]struct{}{},
+	}
+
+	sc := bufio.NewScanner(bytes.NewReader(buf))
+
+	for line := 1; sc.Scan(); line++ {
+		text := strings.TrimSpace(sc.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		id := normalize(text)
+
+		if strings.Trim(id, "0123456789abcdef") != "" {
+			return nil, fmt.Errorf("%s:%d: %q is not hex", path, line, text)
+		}
+
+		inv.ids[id] = struct{}{}
+	}
+
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s. error: %w", path, err)
+	}
+
+	return inv, nil
+}
+
+// Contains reports whether the serial number or the fingerprint of the device is approved.
+func (inv *Inventory) Contains(dev Device) bool {
+	for _, id := range []string{dev.Serial, dev.Fingerprint} {
+		if id == "" {
+			continue
+		}
+
+		if _, ok := inv.ids[normalize(id)]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func normalize(id string) string {
+	id = strings.ToLower(strings.ReplaceAll(id, ":", ""))
+
+	// NOTE: シリアル番号は先頭のゼロの有無で表記が揺れるので取り除いて比較する
+	return strings.TrimLeft(id, "0")
+}
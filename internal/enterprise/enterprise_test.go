@@ -0,0 +1,102 @@
+package enterprise
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+func TestGranted(t *testing.T) {
+	marshal := func(obj map[string]interface{}) []byte {
+		buf, err := cbor.Marshal(obj)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return buf
+	}
+
+	tests := []struct {
+		name string
+		obj  []byte
+		want bool
+	}{
+		{
+			name: "granted",
+			obj:  marshal(map[string]interface{}{"fmt": "packed", "attStmt": map[string]interface{}{}, "authData": []byte{}, "epAtt": true}),
+			want: true,
+		},
+		{
+			name: "denied",
+			obj:  marshal(map[string]interface{}{"fmt": "packed", "attStmt": map[string]interface{}{}, "authData": []byte{}, "epAtt": false}),
+		},
+		{
+			name: "missing",
+			obj:  marshal(map[string]interface{}{"fmt": "packed", "attStmt": map[string]interface{}{}, "authData": []byte{}}),
+		},
+		{
+			name: "malformed",
+			obj:  []byte{0xff},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Granted(tt.obj); got != tt.want {
+				t.Errorf("Granted() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInventoryContains(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "inventory.txt")
+
+	if err := os.WriteFile(path, []byte("# approved devices\n00:30:39\nAB1F1D91\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	inv, err := LoadInventory(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		dev  Device
+		want bool
+	}{
+		{
+			name: "serial with leading zero",
+			dev:  Device{Serial: "3039"},
+			want: true,
+		},
+		{
+			name: "fingerprint",
+			dev:  Device{Serial: "1", Fingerprint: "ab1f1d91"},
+			want: true,
+		},
+		{
+			name: "unknown",
+			dev:  Device{Serial: "1", Fingerprint: "2"},
+		},
+		{
+			name: "empty",
+			dev:  Device{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			if got := inv.Contains(tt.dev); got != tt.want {
+				t.Errorf("Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
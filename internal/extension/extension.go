@@ -0,0 +1,299 @@
+// Package extension builds the WebAuthn extension inputs of the ceremonies and verifies their outputs.
+//
+// Supported extensions are credProps, credProtect, minPinLength, largeBlob and prf. Client extension outputs come from
+// getClientExtensionResults() of the browser and authenticator extension outputs from the authenticator data.
+//
+// prf derives secrets on the authenticator from a salt kept per credential by the relying party. The secrets are for
+// the client only: the client reports whether it got them but never sends them.
+package extension
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+)
+
+// CredProtectPolicies are the credential protection policies of credProtect in the order of their levels 1 to 3.
+var CredProtectPolicies = []string{
+	"userVerificationOptional",
+	"userVerificationOptionalWithCredentialIDList",
+	"userVerificationRequired",
+}
+
+// Config is the extensions requested by the relying party.
+type Config struct {
+	// CredProps asks the client whether the new credential is discoverable.
+	CredProps bool
+
+	// CredProtect is the credential protection policy requested at registration, empty for none.
+	CredProtect string
+	// EnforceCredProtect makes the registration fail when the authenticator cannot apply CredProtect.
+	EnforceCredProtect bool
+
+	// MinPinLength asks the authenticator for its minimum PIN length.
+	MinPinLength bool
+
+	// LargeBlob is the large blob support requested at registration, preferred or required. Empty for none.
+	LargeBlob string
+	// LargeBlobRead reads the large blob at login.
+	LargeBlobRead bool
+
+	// PRF asks whether a new credential supports prf and evaluates it at login with the salt of the credential.
+	PRF bool
+}
+
+// PRFSaltLength is the length of the prf salt of a credential.
+const PRFSaltLength = 32
+
+// NewPRFSalt returns a random prf salt.
+func NewPRFSalt() ([]byte, error) {
+	salt := make([]byte, PRFSaltLength)
+
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, fmt.Errorf("failed to read random. error: %w", err)
+	}
+
+	return salt, nil
+}
+
+// Outputs is the extension outputs of a registration worth keeping with the credential.
+type Outputs struct {
+	// Discoverable is credProps.rk, nil if unknown.
+	Discoverable *bool `json:"discoverable,omitempty"`
+	// CredProtect is the credential protection policy applied by the authenticator, empty if unknown.
+	CredProtect string `json:"credProtect,omitempty"`
+	// MinPinLength is the minimum PIN length of the authenticator, 0 if unknown.
+	MinPinLength int `json:"minPinLength,omitempty"`
+	// LargeBlobSupported is largeBlob.supported, nil if unknown.
+	LargeBlobSupported *bool `json:"largeBlobSupported,omitempty"`
+	// PRF is whether the credential supports prf, nil if unknown.
+	PRF *bool `json:"prf,omitempty"`
+}
+
+// Registration returns the extension inputs of a registration.
+func (cfg Config) Registration() protocol.AuthenticationExtensions {
+	ext := protocol.AuthenticationExtensions{}
+
+	if cfg.CredProps {
+		ext["credProps"] = true
+	}
+
+	if cfg.CredProtect != "" {
+		ext["credentialProtectionPolicy"] = cfg.CredProtect
+		ext["enforceCredentialProtectionPolicy"] = cfg.EnforceCredProtect
+	}
+
+	if cfg.MinPinLength {
+		ext["minPinLength"] = true
+	}
+
+	if cfg.LargeBlob != "" {
+		ext["largeBlob"] = map[string]interface{}{"support": cfg.LargeBlob}
+	}
+
+	if cfg.PRF {
+		ext["prf"] = map[string]interface{}{}
+	}
+
+	return ext
+}
+
+// Assertion returns the extension inputs of a login. salts are the prf salts of the allowed credentials keyed by the
+// base64url credential id; prf is not requested without them since evalByCredential needs allowCredentials.
+func (cfg Config) Assertion(salts map[string][]byte) protocol.AuthenticationExtensions {
+	ext := protocol.AuthenticationExtensions{}
+
+	if cfg.LargeBlobRead {
+		ext["largeBlob"] = map[string]interface{}{"read": true}
+	}
+
+	if cfg.PRF && len(salts) > 0 {
+		eval := make(map[string]interface{}, len(salts))
+
+		for id, salt := range salts {
+			eval[id] = map[string]interface{}{"first": protocol.URLEncodedBase64(salt)}
+		}
+
+		ext["prf"] = map[string]interface{}{"evalByCredential": eval}
+	}
+
+	return ext
+}
+
+// VerifyRegistration verifies the client extension outputs and the authenticator extension outputs (the CBOR
+// extensions of the authenticator data) of a registration. Outputs of extensions which were not requested are
+// ignored.
+func (cfg Config) VerifyRegistration(client protocol.AuthenticationExtensionsClientOutputs, authenticator []byte) (Outputs, error) {
+	var out Outputs
+
+	auth := map[string]interface{}{}
+
+	if len(authenticator) > 0 {
+		if err := webauthncbor.Unmarshal(authenticator, &auth); err != nil {
+			return out, fmt.Errorf("failed to unmarshal authenticator extension outputs. error: %w", err)
+		}
+	}
+
+	if cfg.CredProps {
+		if props, ok := client["credProps"]; ok {
+			rk, err := field[bool](props, "rk")
+			if err != nil {
+				return out, fmt.Errorf("invalid credProps. error: %w", err)
+			}
+
+			out.Discoverable = rk
+		}
+	}
+
+	if cfg.CredProtect != "" {
+		level, ok := auth["credProtect"].(uint64)
+		if _, present := auth["credProtect"]; present && (!ok || level < 1 || int(level) > len(CredProtectPolicies)) {
+			return out, fmt.Errorf("invalid credProtect %v", auth["credProtect"])
+		}
+
+		if ok {
+			out.CredProtect = CredProtectPolicies[level-1]
+		}
+
+		// NOTE: クライアントが強制しているはずだが、認証器が要求したレベルを満たしているかサーバーでも確認する
+		if cfg.EnforceCredProtect && level < uint64(credProtectLevel(cfg.CredProtect)) {
+			return out, fmt.Errorf("credProtect %s is not applied", cfg.CredProtect)
+		}
+	}
+
+	if cfg.MinPinLength {
+		if value, present := auth["minPinLength"]; present {
+			length, ok := value.(uint64)
+			if !ok {
+				return out, fmt.Errorf("invalid minPinLength %v", value)
+			}
+
+			out.MinPinLength = int(length)
+		}
+	}
+
+	if cfg.LargeBlob != "" {
+		if blob, ok := client["largeBlob"]; ok {
+			supported, err := field[bool](blob, "supported")
+			if err != nil {
+				return out, fmt.Errorf("invalid largeBlob. error: %w", err)
+			}
+
+			out.LargeBlobSupported = supported
+		}
+
+		if cfg.LargeBlob == "required" && (out.LargeBlobSupported == nil || !*out.LargeBlobSupported) {
+			return out, errors.New("largeBlob is required but not supported")
+		}
+	}
+
+	if cfg.PRF {
+		enabled, err := prfEnabled(client)
+		if err != nil {
+			return out, err
+		}
+
+		out.PRF = enabled
+	}
+
+	return out, nil
+}
+
+// VerifyAssertion verifies the client extension outputs of a login with the credential. requested is the extension
+// inputs of the login. It returns whether the client got the prf results of the credential, nil if prf was not
+// evaluated for it.
+func (cfg Config) VerifyAssertion(requested protocol.AuthenticationExtensions, credentialID []byte, client protocol.AuthenticationExtensionsClientOutputs) (*bool, error) {
+	if cfg.LargeBlobRead {
+		if blob, ok := client["largeBlob"]; ok {
+			value, err := field[interface{}](blob, "blob")
+			if err != nil {
+				return nil, fmt.Errorf("invalid largeBlob. error: %w", err)
+			}
+
+			// NOTE: JSON では base64url の文字列、MessagePack ではバイト列で送られる
+			if value != nil {
+				switch (*value).(type) {
+				case string, []byte:
+				default:
+					return nil, fmt.Errorf("invalid largeBlob. error: blob is %T", *value)
+				}
+			}
+		}
+	}
+
+	if !cfg.PRF {
+		return nil, nil
+	}
+
+	enabled, err := prfEnabled(client)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: prf を要求していないクレデンシャルの結果は信用しない
+	prf, _ := requested["prf"].(map[string]interface{})
+	eval, _ := prf["evalByCredential"].(map[string]interface{})
+
+	if _, ok := eval[base64.RawURLEncoding.EncodeToString(credentialID)]; !ok {
+		return nil, nil
+	}
+
+	return enabled, nil
+}
+
+// prfEnabled returns prf.enabled of the client extension outputs. The outputs must not carry prf.results since the
+// derived secrets stay on the client.
+func prfEnabled(client protocol.AuthenticationExtensionsClientOutputs) (*bool, error) {
+	prf, ok := client["prf"]
+	if !ok {
+		return nil, nil
+	}
+
+	if obj, ok := prf.(map[string]interface{}); ok {
+		if _, ok := obj["results"]; ok {
+			return nil, errors.New("prf results must not be sent to the server")
+		}
+	}
+
+	enabled, err := field[bool](prf, "enabled")
+	if err != nil {
+		return nil, fmt.Errorf("invalid prf. error: %w", err)
+	}
+
+	return enabled, nil
+}
+
+// field returns the member of an extension output object, nil if it is absent.
+func field[T any](output interface{}, name string) (*T, error) {
+	obj, ok := output.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("output is not an object")
+	}
+
+	value, ok := obj[name]
+	if !ok {
+		return nil, nil
+	}
+
+	v, ok := value.(T)
+	if !ok {
+		return nil, fmt.Errorf("%s is %T", name, value)
+	}
+
+	return &v, nil
+}
+
+func credProtectLevel(policy string) int {
+	for i, p := range CredProtectPolicies {
+		if p == policy {
+			return i + 1
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,343 @@
+package extension
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+)
+
+// authenticatorOutputs returns the CBOR authenticator extension outputs.
+func authenticatorOutputs(t *testing.T, outputs map[string]interface{}) []byte {
+	t.Helper()
+
+	if outputs == nil {
+		return nil
+	}
+
+	buf, err := webauthncbor.Marshal(outputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return buf
+}
+
+func TestVerifyRegistration(t *testing.T) {
+	tests := []struct {
+		name          string
+		cfg           Config
+		client        protocol.AuthenticationExtensionsClientOutputs
+		authenticator map[string]interface{}
+		want          func(t *testing.T, out Outputs)
+		wantErr       bool
+	}{
+		{
+			name:   "credProps",
+			cfg:    Config{CredProps: true},
+			client: protocol.AuthenticationExtensionsClientOutputs{"credProps": map[string]interface{}{"rk": true}},
+			want: func(t *testing.T, out Outputs) {
+				if out.Discoverable == nil || !*out.Discoverable {
+					t.Errorf("discoverable = %v, want true", out.Discoverable)
+				}
+			},
+		},
+		{
+			name:    "invalid credProps",
+			cfg:     Config{CredProps: true},
+			client:  protocol.AuthenticationExtensionsClientOutputs{"credProps": map[string]interface{}{"rk": "yes"}},
+			wantErr: true,
+		},
+		{
+			name:   "credProps not requested",
+			client: protocol.AuthenticationExtensionsClientOutputs{"credProps": map[string]interface{}{"rk": "yes"}},
+			want: func(t *testing.T, out Outputs) {
+				if out.Discoverable != nil {
+					t.Errorf("discoverable = %v, want nil", *out.Discoverable)
+				}
+			},
+		},
+		{
+			name:          "credProtect applied",
+			cfg:           Config{CredProtect: "userVerificationOptionalWithCredentialIDList", EnforceCredProtect: true},
+			authenticator: map[string]interface{}{"credProtect": 2},
+			want: func(t *testing.T, out Outputs) {
+				if out.CredProtect != "userVerificationOptionalWithCredentialIDList" {
+					t.Errorf("credProtect = %q, want userVerificationOptionalWithCredentialIDList", out.CredProtect)
+				}
+			},
+		},
+		{
+			name:          "credProtect higher than requested",
+			cfg:           Config{CredProtect: "userVerificationOptionalWithCredentialIDList", EnforceCredProtect: true},
+			authenticator: map[string]interface{}{"credProtect": 3},
+			want: func(t *testing.T, out Outputs) {
+				if out.CredProtect != "userVerificationRequired" {
+					t.Errorf("credProtect = %q, want userVerificationRequired", out.CredProtect)
+				}
+			},
+		},
+		{
+			name:          "credProtect lower than enforced",
+			cfg:           Config{CredProtect: "userVerificationRequired", EnforceCredProtect: true},
+			authenticator: map[string]interface{}{"credProtect": 1},
+			wantErr:       true,
+		},
+		{
+			name:    "credProtect missing when enforced",
+			cfg:     Config{CredProtect: "userVerificationRequired", EnforceCredProtect: true},
+			wantErr: true,
+		},
+		{
+			name:          "credProtect lower than requested",
+			cfg:           Config{CredProtect: "userVerificationRequired"},
+			authenticator: map[string]interface{}{"credProtect": 1},
+			want: func(t *testing.T, out Outputs) {
+				if out.CredProtect != "userVerificationOptional" {
+					t.Errorf("credProtect = %q, want userVerificationOptional", out.CredProtect)
+				}
+			},
+		},
+		{
+			name:          "credProtect out of range",
+			cfg:           Config{CredProtect: "userVerificationRequired"},
+			authenticator: map[string]interface{}{"credProtect": 4},
+			wantErr:       true,
+		},
+		{
+			name:          "minPinLength",
+			cfg:           Config{MinPinLength: true},
+			authenticator: map[string]interface{}{"minPinLength": 6},
+			want: func(t *testing.T, out Outputs) {
+				if out.MinPinLength != 6 {
+					t.Errorf("minPinLength = %d, want 6", out.MinPinLength)
+				}
+			},
+		},
+		{
+			name:          "invalid minPinLength",
+			cfg:           Config{MinPinLength: true},
+			authenticator: map[string]interface{}{"minPinLength": "six"},
+			wantErr:       true,
+		},
+		{
+			name:   "largeBlob required and supported",
+			cfg:    Config{LargeBlob: "required"},
+			client: protocol.AuthenticationExtensionsClientOutputs{"largeBlob": map[string]interface{}{"supported": true}},
+			want: func(t *testing.T, out Outputs) {
+				if out.LargeBlobSupported == nil || !*out.LargeBlobSupported {
+					t.Errorf("largeBlobSupported = %v, want true", out.LargeBlobSupported)
+				}
+			},
+		},
+		{
+			name:    "largeBlob required but not supported",
+			cfg:     Config{LargeBlob: "required"},
+			client:  protocol.AuthenticationExtensionsClientOutputs{"largeBlob": map[string]interface{}{"supported": false}},
+			wantErr: true,
+		},
+		{
+			name:    "largeBlob required without output",
+			cfg:     Config{LargeBlob: "required"},
+			wantErr: true,
+		},
+		{
+			name:   "largeBlob preferred but not supported",
+			cfg:    Config{LargeBlob: "preferred"},
+			client: protocol.AuthenticationExtensionsClientOutputs{"largeBlob": map[string]interface{}{"supported": false}},
+			want: func(t *testing.T, out Outputs) {
+				if out.LargeBlobSupported == nil || *out.LargeBlobSupported {
+					t.Errorf("largeBlobSupported = %v, want false", out.LargeBlobSupported)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := tt.cfg.VerifyRegistration(tt.client, authenticatorOutputs(t, tt.authenticator))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.want != nil {
+				tt.want(t, out)
+			}
+		})
+	}
+}
+
+func TestVerifyRegistrationPRF(t *testing.T) {
+	cfg := Config{PRF: true}
+
+	tests := []struct {
+		name    string
+		client  protocol.AuthenticationExtensionsClientOutputs
+		want    *bool
+		wantErr bool
+	}{
+		{
+			name:   "enabled",
+			client: protocol.AuthenticationExtensionsClientOutputs{"prf": map[string]interface{}{"enabled": true}},
+			want:   ptr(true),
+		},
+		{
+			name:   "not enabled",
+			client: protocol.AuthenticationExtensionsClientOutputs{"prf": map[string]interface{}{"enabled": false}},
+			want:   ptr(false),
+		},
+		{
+			name: "no output",
+		},
+		{
+			name: "results",
+			client: protocol.AuthenticationExtensionsClientOutputs{"prf": map[string]interface{}{
+				"enabled": true,
+				"results": map[string]interface{}{"first": "c2VjcmV0"},
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid enabled",
+			client:  protocol.AuthenticationExtensionsClientOutputs{"prf": map[string]interface{}{"enabled": "true"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := cfg.VerifyRegistration(tt.client, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !equal(out.PRF, tt.want) {
+				t.Errorf("prf = %v, want %v", format(out.PRF), format(tt.want))
+			}
+		})
+	}
+}
+
+func TestVerifyAssertion(t *testing.T) {
+	credentialID := []byte("evaluated")
+
+	salts := map[string][]byte{
+		base64.RawURLEncoding.EncodeToString(credentialID): []byte("salt"),
+	}
+
+	enabled := protocol.AuthenticationExtensionsClientOutputs{"prf": map[string]interface{}{"enabled": true}}
+
+	tests := []struct {
+		name         string
+		cfg          Config
+		requested    protocol.AuthenticationExtensions
+		credentialID []byte
+		client       protocol.AuthenticationExtensionsClientOutputs
+		want         *bool
+		wantErr      bool
+	}{
+		{
+			name:         "prf evaluated",
+			cfg:          Config{PRF: true},
+			requested:    Config{PRF: true}.Assertion(salts),
+			credentialID: credentialID,
+			client:       enabled,
+			want:         ptr(true),
+		},
+		{
+			name:         "prf not enabled",
+			cfg:          Config{PRF: true},
+			requested:    Config{PRF: true}.Assertion(salts),
+			credentialID: credentialID,
+			client:       protocol.AuthenticationExtensionsClientOutputs{"prf": map[string]interface{}{"enabled": false}},
+			want:         ptr(false),
+		},
+		{
+			name:         "prf without output",
+			cfg:          Config{PRF: true},
+			requested:    Config{PRF: true}.Assertion(salts),
+			credentialID: credentialID,
+		},
+		{
+			name:         "credential not in evalByCredential",
+			cfg:          Config{PRF: true},
+			requested:    Config{PRF: true}.Assertion(salts),
+			credentialID: []byte("other"),
+			client:       enabled,
+		},
+		{
+			name:         "discoverable login without evalByCredential",
+			cfg:          Config{PRF: true},
+			requested:    Config{PRF: true}.Assertion(nil),
+			credentialID: credentialID,
+			client:       enabled,
+		},
+		{
+			name:         "prf disabled",
+			requested:    Config{PRF: true}.Assertion(salts),
+			credentialID: credentialID,
+			client:       enabled,
+		},
+		{
+			name:         "results",
+			cfg:          Config{PRF: true},
+			requested:    Config{PRF: true}.Assertion(salts),
+			credentialID: credentialID,
+			client: protocol.AuthenticationExtensionsClientOutputs{"prf": map[string]interface{}{
+				"results": map[string]interface{}{"first": "c2VjcmV0"},
+			}},
+			wantErr: true,
+		},
+		{
+			name:         "results of a credential not in evalByCredential",
+			cfg:          Config{PRF: true},
+			requested:    Config{PRF: true}.Assertion(salts),
+			credentialID: []byte("other"),
+			client: protocol.AuthenticationExtensionsClientOutputs{"prf": map[string]interface{}{
+				"results": map[string]interface{}{"first": "c2VjcmV0"},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.cfg.VerifyAssertion(tt.requested, tt.credentialID, tt.client)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !equal(got, tt.want) {
+				t.Errorf("prf = %v, want %v", format(got), format(tt.want))
+			}
+		})
+	}
+}
+
+func ptr(v bool) *bool {
+	return &v
+}
+
+func equal(a, b *bool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+func format(v *bool) string {
+	if v == nil {
+		return "nil"
+	}
+
+	return fmt.Sprint(*v)
+}
@@ -0,0 +1,175 @@
+// Package inspect decodes the raw attestationObject and clientDataJSON of a registration into a report to debug
+// authenticators with.
+package inspect
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+)
+
+// Report is the decoded attestation of a credential.
+type Report struct {
+	Format       string
+	RPIDHash     []byte
+	Flags        protocol.AuthenticatorFlags
+	AAGUID       []byte
+	SignCount    uint32
+	CredentialID []byte
+	PublicKey    PublicKey
+	Certificates []Certificate
+
+	// Extensions are the authenticator extension outputs with byte strings encoded in base64url.
+	Extensions map[string]interface{}
+
+	ClientData ClientData
+}
+
+// ClientData is the client data collected by the browser.
+type ClientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+	TopOrigin   string `json:"topOrigin"`
+}
+
+// PublicKey is the parameters of the COSE key of the credential.
+type PublicKey struct {
+	KeyType   int64
+	Algorithm int64
+	Curve     int64
+	// Size is the size of the key in bits.
+	Size int
+}
+
+// Certificate is an attestation certificate of the x5c.
+type Certificate struct {
+	Subject     string
+	Issuer      string
+	Serial      string
+	NotBefore   time.Time
+	NotAfter    time.Time
+	Fingerprint string
+}
+
+// Attestation decodes the attestation object and the client data of a registration.
+func Attestation(attestationObject, clientDataJSON []byte) (*Report, error) {
+	var att protocol.AttestationObject
+
+	if err := webauthncbor.Unmarshal(attestationObject, &att); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal attestation object. error: %w", err)
+	}
+
+	if err := att.AuthData.Unmarshal(att.RawAuthData); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal authenticator data. error: %w", err)
+	}
+
+	ad := att.AuthData
+
+	rep := &Report{
+		Format:       att.Format,
+		RPIDHash:     ad.RPIDHash,
+		Flags:        ad.Flags,
+		AAGUID:       ad.AttData.AAGUID,
+		SignCount:    ad.Counter,
+		CredentialID: ad.AttData.CredentialID,
+	}
+
+	if err := json.Unmarshal(clientDataJSON, &rep.ClientData); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal client data. error: %w", err)
+	}
+
+	key, err := webauthncose.ParsePublicKey(ad.AttData.CredentialPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key. error: %w", err)
+	}
+
+	switch key := key.(type) {
+	case webauthncose.EC2PublicKeyData:
+		rep.PublicKey = PublicKey{KeyType: key.KeyType, Algorithm: key.Algorithm, Curve: key.Curve, Size: len(key.XCoord) * 8}
+	case webauthncose.RSAPublicKeyData:
+		rep.PublicKey = PublicKey{KeyType: key.KeyType, Algorithm: key.Algorithm, Size: len(key.Modulus) * 8}
+	case webauthncose.OKPPublicKeyData:
+		rep.PublicKey = PublicKey{KeyType: key.KeyType, Algorithm: key.Algorithm, Curve: key.Curve, Size: len(key.XCoord) * 8}
+	}
+
+	if x5c, ok := att.AttStatement["x5c"].([]interface{}); ok {
+		for _, value := range x5c {
+			der, ok := value.([]byte)
+			if !ok {
+				continue
+			}
+
+			cert, err := x509.ParseCertificate(der)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse x5c. error: %w", err)
+			}
+
+			sum := sha256.Sum256(cert.Raw)
+
+			rep.Certificates = append(rep.Certificates, Certificate{
+				Subject:     cert.Subject.String(),
+				Issuer:      cert.Issuer.String(),
+				Serial:      hex.EncodeToString(cert.SerialNumber.Bytes()),
+				NotBefore:   cert.NotBefore,
+				NotAfter:    cert.NotAfter,
+				Fingerprint: hex.EncodeToString(sum[:]),
+			})
+		}
+	}
+
+	if ad.Flags.HasExtensions() && len(ad.ExtData) > 0 {
+		var ext map[string]interface{}
+
+		if err := webauthncbor.Unmarshal(ad.ExtData, &ext); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal extensions. error: %w", err)
+		}
+
+		rep.Extensions = jsonValue(ext).(map[string]interface{})
+	}
+
+	return rep, nil
+}
+
+// jsonValue converts a value decoded from CBOR into a value encoding/json can marshal.
+func jsonValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case []byte:
+		return base64.RawURLEncoding.EncodeToString(v)
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+
+		for key, value := range v {
+			out[key] = jsonValue(value)
+		}
+
+		return out
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(v))
+
+		for key, value := range v {
+			out[fmt.Sprint(key)] = jsonValue(value)
+		}
+
+		return out
+	case []interface{}:
+		out := make([]interface{}, 0, len(v))
+
+		for _, value := range v {
+			out = append(out, jsonValue(value))
+		}
+
+		return out
+	default:
+		return v
+	}
+}
@@ -0,0 +1,155 @@
+package keyring
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrUnknownKey is returned when a sealed value was produced with a key that is not in the keyring.
+var ErrUnknownKey = errors.New("unknown key id")
+
+// Key is an AES key identified by ID. The ID is written in front of every value sealed with the key.
+type Key struct {
+	ID     string
+	Secret []byte
+}
+
+// Keyring seals values with AES-GCM using its primary key and opens values sealed with any of its keys,
+// so that a new key can be rolled out while values sealed with the previous keys are still accepted.
+//
+// A sealed value has the form "<key id>.<base64url(nonce || ciphertext)>".
+type Keyring struct {
+	primary string
+	aeads   map[string]cipher.AEAD
+}
+
+// New returns a Keyring. The first key is the primary key used for sealing.
+func New(keys ...Key) (*Keyring, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("at least one key is required")
+	}
+
+	kr := &Keyring{
+		primary: keys[0].ID,
+		aeads:   make(map[string]cipher.AEAD, len(keys)),
+	}
+
+	for _, key := range keys {
+		if key.ID == "" || strings.ContainsAny(key.ID, ".,:") {
+			return nil, fmt.Errorf("key id %q must not be empty nor contain '.', ',' or ':'", key.ID)
+		}
+
+		if _, ok := kr.aeads[key.ID]; ok {
+			return nil, fmt.Errorf("key id %q is duplicated", key.ID)
+		}
+
+		block, err := aes.NewCipher(key.Secret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create cipher for key %q. error: %w", key.ID, err)
+		}
+
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create gcm for key %q. error: %w", key.ID, err)
+		}
+
+		kr.aeads[key.ID] = aead
+	}
+
+	return kr, nil
+}
+
+// Parse parses keys written as comma separated "<key id>:<base64 encoded secret>" pairs, newest first.
+// The secret must be 16, 24 or 32 bytes long.
+func Parse(value string) ([]Key, error) {
+	var keys []Key
+
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		id, secret, ok := strings.Cut(pair, ":")
+		if !ok {
+			return nil, fmt.Errorf("key %q must be written as <key id>:<base64 encoded secret>", pair)
+		}
+
+		dec, err := base64.StdEncoding.DecodeString(secret)
+		if err != nil {
+			return nil, fmt.Errorf("failed to base64 decode key %q. error: %w", id, err)
+		}
+
+		keys = append(keys, Key{
+			ID:     id,
+			Secret: dec,
+		})
+	}
+
+	return keys, nil
+}
+
+// Generate returns a random 32 bytes key.
+func Generate(id string) (Key, error) {
+	secret := make([]byte, 32)
+
+	if _, err := io.ReadFull(rand.Reader, secret); err != nil {
+		return Key{}, fmt.Errorf("failed to read random. error: %w", err)
+	}
+
+	return Key{
+		ID:     id,
+		Secret: secret,
+	}, nil
+}
+
+// Seal encrypts and authenticates plaintext with the primary key. additionalData is authenticated but not
+// encrypted and must be passed to Open unchanged; use it to bind a value to its purpose.
+func (kr *Keyring) Seal(plaintext []byte, additionalData []byte) (string, error) {
+	aead := kr.aeads[kr.primary]
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", fmt.Errorf("failed to read random. error: %w", err)
+	}
+
+	sealed := aead.Seal(nonce, nonce, plaintext, additionalData)
+
+	return kr.primary + "." + base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// Open decrypts a value produced by Seal with any key of the keyring.
+func (kr *Keyring) Open(value string, additionalData []byte) ([]byte, error) {
+	id, payload, ok := strings.Cut(value, ".")
+	if !ok {
+		return nil, errors.New("sealed value has no key id")
+	}
+
+	aead, ok := kr.aeads[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKey, id)
+	}
+
+	sealed, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to base64 decode. error: %w", err)
+	}
+
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("sealed value is too short")
+	}
+
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open. error: %w", err)
+	}
+
+	return plaintext, nil
+}
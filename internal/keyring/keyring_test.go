@@ -0,0 +1,261 @@
+package keyring
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func mustGenerate(t *testing.T, id string) Key {
+	t.Helper()
+
+	key, err := Generate(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return key
+}
+
+func mustNew(t *testing.T, keys ...Key) *Keyring {
+	t.Helper()
+
+	kr, err := New(keys...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return kr
+}
+
+func TestSealOpen(t *testing.T) {
+	oldKey := mustGenerate(t, "old")
+	newKey := mustGenerate(t, "new")
+
+	sealedByOld, err := mustNew(t, oldKey).Seal([]byte("session"), []byte("cookie"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sealedByNew, err := mustNew(t, newKey, oldKey).Seal([]byte("session"), []byte("cookie"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tampered := []byte(sealedByNew)
+	if tampered[len(tampered)/2] == 'A' {
+		tampered[len(tampered)/2] = 'B'
+	} else {
+		tampered[len(tampered)/2] = 'A'
+	}
+
+	tests := []struct {
+		name    string
+		keyring *Keyring
+		value   string
+		ad      string
+		wantErr error
+		wantAny bool
+	}{
+		{
+			name:    "sealed by the primary key",
+			keyring: mustNew(t, newKey, oldKey),
+			value:   sealedByNew,
+			ad:      "cookie",
+		},
+		{
+			name:    "sealed by the previous key after rotation",
+			keyring: mustNew(t, newKey, oldKey),
+			value:   sealedByOld,
+			ad:      "cookie",
+		},
+		{
+			name:    "previous key is retired",
+			keyring: mustNew(t, newKey),
+			value:   sealedByOld,
+			ad:      "cookie",
+			wantErr: ErrUnknownKey,
+		},
+		{
+			name:    "additional data differs",
+			keyring: mustNew(t, newKey, oldKey),
+			value:   sealedByNew,
+			ad:      "other",
+			wantAny: true,
+		},
+		{
+			name:    "tampered",
+			keyring: mustNew(t, newKey, oldKey),
+			value:   string(tampered),
+			ad:      "cookie",
+			wantAny: true,
+		},
+		{
+			name:    "no key id",
+			keyring: mustNew(t, newKey),
+			value:   strings.TrimPrefix(sealedByNew, "new."),
+			ad:      "cookie",
+			wantAny: true,
+		},
+		{
+			name:    "too short",
+			keyring: mustNew(t, newKey),
+			value:   "new.AAAA",
+			ad:      "cookie",
+			wantAny: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.keyring.Open(tt.value, []byte(tt.ad))
+
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("error = %v, want %v", err, tt.wantErr)
+				}
+			case tt.wantAny:
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+			default:
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !bytes.Equal(got, []byte("session")) {
+					t.Errorf("plaintext = %q, want %q", got, "session")
+				}
+			}
+		})
+	}
+}
+
+func TestSealUsesPrimaryKey(t *testing.T) {
+	kr := mustNew(t, mustGenerate(t, "new"), mustGenerate(t, "old"))
+
+	first, err := kr.Seal([]byte("session"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := kr.Seal([]byte("session"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(first, "new.") {
+		t.Errorf("sealed value %q is not sealed by the primary key", first)
+	}
+
+	if first == second {
+		t.Error("sealed values must differ by their nonces")
+	}
+}
+
+func TestNew(t *testing.T) {
+	secret := make([]byte, 32)
+
+	tests := []struct {
+		name    string
+		keys    []Key
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			keys: []Key{{ID: "a", Secret: secret}, {ID: "b", Secret: secret[:16]}},
+		},
+		{
+			name:    "no keys",
+			wantErr: true,
+		},
+		{
+			name:    "empty id",
+			keys:    []Key{{ID: "", Secret: secret}},
+			wantErr: true,
+		},
+		{
+			name:    "id with separator",
+			keys:    []Key{{ID: "a.b", Secret: secret}},
+			wantErr: true,
+		},
+		{
+			name:    "duplicated id",
+			keys:    []Key{{ID: "a", Secret: secret}, {ID: "a", Secret: secret}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid secret length",
+			keys:    []Key{{ID: "a", Secret: secret[:10]}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(tt.keys...); (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	secret := base64.StdEncoding.EncodeToString(make([]byte, 32))
+
+	tests := []struct {
+		name    string
+		value   string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:  "newest first",
+			value: "new:" + secret + ", old:" + secret,
+			want:  []string{"new", "old"},
+		},
+		{
+			name:  "empty entries",
+			value: ",new:" + secret + ",",
+			want:  []string{"new"},
+		},
+		{
+			name:    "no separator",
+			value:   "new" + secret,
+			wantErr: true,
+		},
+		{
+			name:    "invalid base64",
+			value:   "new:!!!",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			keys, err := Parse(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			var got []string
+
+			for _, key := range keys {
+				got = append(got, key.ID)
+			}
+
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("ids = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,313 @@
+// Package mds loads the FIDO Metadata Service (MDS3) BLOB and looks up its entries by AAGUID.
+//
+// Unlike metadata.PopulateMetadata the BLOB can be read from a local file, and its signing certificate chain is
+// verified against a configured root instead of the hardcoded production root. Revocation lists are not fetched so
+// that the BLOB can be loaded offline.
+package mds
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-webauthn/webauthn/metadata"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Service keeps the entries of the latest verified BLOB.
+type Service struct {
+	source string
+	roots  []*x509.Certificate
+	client *http.Client
+
+	// onUpdate is called with the entries of every newly loaded BLOB.
+	onUpdate func(entries []metadata.MetadataBLOBPayloadEntry)
+
+	mu      sync.RWMutex
+	number  int
+	entries map[string]metadata.MetadataBLOBPayloadEntry
+}
+
+// New returns a service loading the BLOB from source, a file path or an http(s) URL, signed by a chain ending in
+// one of roots. onUpdate may be nil.
+func New(source string, roots []*x509.Certificate, onUpdate func(entries []metadata.MetadataBLOBPayloadEntry)) *Service {
+	return &Service{
+		source: source,
+		roots:  roots,
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+		onUpdate: onUpdate,
+		entries:  map[string]metadata.MetadataBLOBPayloadEntry{},
+	}
+}
+
+// LoadRoots reads the PEM encoded root certificates at path.
+func LoadRoots(path string) ([]*x509.Certificate, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s. error: %w", path, err)
+	}
+
+	var roots []*x509.Certificate
+
+	for {
+		var block *pem.Block
+
+		block, buf = pem.Decode(buf)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate in %s. error: %w", path, err)
+		}
+
+		roots = append(roots, cert)
+	}
+
+	if len(roots) == 0 {
+		return nil, fmt.Errorf("no certificate found in %s", path)
+	}
+
+	return roots, nil
+}
+
+// Refresh loads the BLOB from the source. A BLOB whose serial number is not newer than the loaded one is ignored.
+func (svc *Service) Refresh(ctx context.Context) error {
+	blob, err := svc.fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	payload, err := Parse(blob, svc.roots, time.Now())
+	if err != nil {
+		return err
+	}
+
+	svc.mu.Lock()
+
+	if payload.Number <= svc.number {
+		svc.mu.Unlock()
+
+		return nil
+	}
+
+	entries := make(map[string]metadata.MetadataBLOBPayloadEntry, len(payload.Entries))
+
+	for _, entry := range payload.Entries {
+		// NOTE: UAF や U2F の認証器は AAGUID を持たないので FIDO2 の認証器だけを対象にする
+		if entry.AaGUID == "" {
+			continue
+		}
+
+		entries[strings.ToLower(entry.AaGUID)] = entry
+	}
+
+	svc.number = payload.Number
+	svc.entries = entries
+
+	svc.mu.Unlock()
+
+	slog.InfoContext(ctx, "loaded metadata BLOB", slog.Int("no", payload.Number), slog.Int("entries", len(entries)), slog.String("nextUpdate", payload.NextUpdate))
+
+	// NOTE: オフラインで使えるように古い BLOB も読み込むが、失効した認証器を見逃しうるので警告する
+	if Outdated(payload, time.Now()) {
+		slog.WarnContext(ctx, "metadata BLOB is outdated, update the source", slog.Int("no", payload.Number), slog.String("nextUpdate", payload.NextUpdate))
+	}
+
+	if svc.onUpdate != nil {
+		svc.onUpdate(payload.Entries)
+	}
+
+	return nil
+}
+
+// Run refreshes the BLOB every interval until ctx is done.
+func (svc *Service) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := svc.Refresh(ctx); err != nil {
+				slog.ErrorContext(ctx, fmt.Sprintf("failed to refresh metadata BLOB. error: %s", err))
+			}
+		}
+	}
+}
+
+// Lookup returns the entry of the authenticator model identified by the AAGUID.
+func (svc *Service) Lookup(aaguid []byte) (metadata.MetadataBLOBPayloadEntry, bool) {
+	if len(aaguid) != 16 {
+		return metadata.MetadataBLOBPayloadEntry{}, false
+	}
+
+	key := fmt.Sprintf("%x-%x-%x-%x-%x", aaguid[0:4], aaguid[4:6], aaguid[6:8], aaguid[8:10], aaguid[10:16])
+
+	svc.mu.RLock()
+	defer svc.mu.RUnlock()
+
+	entry, ok := svc.entries[key]
+
+	return entry, ok
+}
+
+// Outdated reports whether the nextUpdate date of the payload has passed, which means a newer BLOB should have
+// been published. A payload whose nextUpdate is not a date is outdated too.
+func Outdated(payload *metadata.MetadataBLOBPayload, now time.Time) bool {
+	nextUpdate, err := time.Parse(time.DateOnly, payload.NextUpdate)
+	if err != nil {
+		return true
+	}
+
+	// NOTE: nextUpdate の日のうちは新しい BLOB を待つ
+	return !now.Before(nextUpdate.AddDate(0, 0, 1))
+}
+
+// Status returns the status of the most recent status report of the entry, or empty if it has none.
+func Status(entry metadata.MetadataBLOBPayloadEntry) metadata.AuthenticatorStatus {
+	var latest *metadata.StatusReport
+
+	for i := range entry.StatusReports {
+		// NOTE: effectiveDate は ISO-8601 の日付なので文字列で比較できる
+		if latest == nil || entry.StatusReports[i].EffectiveDate >= latest.EffectiveDate {
+			latest = &entry.StatusReports[i]
+		}
+	}
+
+	if latest == nil {
+		return ""
+	}
+
+	return latest.Status
+}
+
+func (svc *Service) fetch(ctx context.Context) ([]byte, error) {
+	if !strings.HasPrefix(svc.source, "http://") && !strings.HasPrefix(svc.source, "https://") {
+		buf, err := os.ReadFile(svc.source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s. error: %w", svc.source, err)
+		}
+
+		return buf, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, svc.source, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request. error: %w", err)
+	}
+
+	res, err := svc.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s. error: %w", svc.source, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get %s. status: %s", svc.source, res.Status)
+	}
+
+	buf, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s. error: %w", svc.source, err)
+	}
+
+	return buf, nil
+}
+
+// Parse verifies the BLOB, a JWT signed by the certificate chain in its x5c header, and returns its payload.
+// The chain must end in one of roots. Without x5c the roots themselves are the signing certificates.
+func Parse(blob []byte, roots []*x509.Certificate, now time.Time) (*metadata.MetadataBLOBPayload, error) {
+	pool := x509.NewCertPool()
+
+	for _, root := range roots {
+		pool.AddCert(root)
+	}
+
+	token, err := jwt.Parse(strings.TrimSpace(string(blob)), func(token *jwt.Token) (interface{}, error) {
+		// NOTE: x5u はオフラインで取得できないので受け付けない
+		if _, ok := token.Header["x5u"]; ok {
+			return nil, errors.New("x5u is not supported")
+		}
+
+		x5c, ok := token.Header["x5c"].([]interface{})
+		if !ok || len(x5c) == 0 {
+			keys := jwt.VerificationKeySet{}
+
+			for _, root := range roots {
+				keys.Keys = append(keys.Keys, root.PublicKey)
+			}
+
+			return keys, nil
+		}
+
+		chain := make([]*x509.Certificate, 0, len(x5c))
+
+		for _, value := range x5c {
+			der, err := base64.StdEncoding.DecodeString(fmt.Sprint(value))
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode x5c. error: %w", err)
+			}
+
+			cert, err := x509.ParseCertificate(der)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse x5c. error: %w", err)
+			}
+
+			chain = append(chain, cert)
+		}
+
+		intermediates := x509.NewCertPool()
+
+		for _, cert := range chain[1:] {
+			intermediates.AddCert(cert)
+		}
+
+		if _, err := chain[0].Verify(x509.VerifyOptions{
+			Roots:         pool,
+			Intermediates: intermediates,
+			CurrentTime:   now,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		}); err != nil {
+			return nil, fmt.Errorf("failed to verify certificate chain. error: %w", err)
+		}
+
+		return chain[0].PublicKey, nil
+	}, jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512", "PS256", "PS384", "PS512"}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify metadata BLOB. error: %w", err)
+	}
+
+	claims, err := json.Marshal(token.Claims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal metadata BLOB payload. error: %w", err)
+	}
+
+	var payload metadata.MetadataBLOBPayload
+
+	if err := json.Unmarshal(claims, &payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal metadata BLOB payload. error: %w", err)
+	}
+
+	return &payload, nil
+}
@@ -0,0 +1,193 @@
+package mds
+
+import (
+	"crypto/ecdsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-webauthn/webauthn/metadata"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/certtest"
+)
+
+func issue(t *testing.T, parent *certtest.Cert, tmpl *x509.Certificate) *certtest.Cert {
+	t.Helper()
+
+	var (
+		cert *certtest.Cert
+		err  error
+	)
+
+	if parent == nil {
+		cert, err = certtest.New(tmpl)
+	} else {
+		cert, err = parent.Issue(tmpl)
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return cert
+}
+
+// sign returns a BLOB signed by key with the certificates in its x5c header.
+func sign(t *testing.T, key *ecdsa.PrivateKey, header map[string]interface{}, chain ...*certtest.Cert) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"no":         7,
+		"nextUpdate": "2030-01-01",
+		"entries":    []interface{}{},
+	})
+
+	for key, value := range header {
+		token.Header[key] = value
+	}
+
+	if len(chain) > 0 {
+		x5c := make([]string, 0, len(chain))
+
+		for _, cert := range chain {
+			x5c = append(x5c, base64.StdEncoding.EncodeToString(cert.Certificate.Raw))
+		}
+
+		token.Header["x5c"] = x5c
+	}
+
+	blob, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return blob
+}
+
+func TestParse(t *testing.T) {
+	root := issue(t, nil, &x509.Certificate{Subject: pkix.Name{CommonName: "root"}, IsCA: true})
+
+	intermediate := issue(t, root, &x509.Certificate{Subject: pkix.Name{CommonName: "intermediate"}, IsCA: true})
+
+	leaf := issue(t, intermediate, &x509.Certificate{Subject: pkix.Name{CommonName: "leaf"}})
+
+	expired := issue(t, intermediate, &x509.Certificate{
+		Subject:   pkix.Name{CommonName: "expired"},
+		NotBefore: time.Now().Add(-48 * time.Hour),
+		NotAfter:  time.Now().Add(-24 * time.Hour),
+	})
+
+	other := issue(t, nil, &x509.Certificate{Subject: pkix.Name{CommonName: "other"}, IsCA: true})
+
+	valid := sign(t, leaf.Key, nil, leaf, intermediate)
+
+	// NOTE: 署名の途中の文字を変える。末尾の文字はパディングのビットしか変わらないことがある
+	parts := strings.Split(valid, ".")
+	signature := []byte(parts[2])
+	signature[len(signature)/2] ^= 'A' ^ 'B'
+	tampered := strings.Join([]string{parts[0], parts[1], string(signature)}, ".")
+
+	tests := []struct {
+		name    string
+		blob    string
+		roots   []*x509.Certificate
+		wantErr bool
+	}{
+		{
+			name:  "chain to the root",
+			blob:  valid,
+			roots: []*x509.Certificate{root.Certificate},
+		},
+		{
+			name:  "signed by the root without x5c",
+			blob:  sign(t, root.Key, nil),
+			roots: []*x509.Certificate{root.Certificate},
+		},
+		{
+			name:    "wrong root",
+			blob:    valid,
+			roots:   []*x509.Certificate{other.Certificate},
+			wantErr: true,
+		},
+		{
+			name:    "wrong root without x5c",
+			blob:    sign(t, root.Key, nil),
+			roots:   []*x509.Certificate{other.Certificate},
+			wantErr: true,
+		},
+		{
+			name:    "tampered signature",
+			blob:    tampered,
+			roots:   []*x509.Certificate{root.Certificate},
+			wantErr: true,
+		},
+		{
+			name:    "signed by another key than the leaf",
+			blob:    sign(t, other.Key, nil, leaf, intermediate),
+			roots:   []*x509.Certificate{root.Certificate},
+			wantErr: true,
+		},
+		{
+			name:    "x5u header",
+			blob:    sign(t, leaf.Key, map[string]interface{}{"x5u": "https://example.com/chain.pem"}, leaf, intermediate),
+			roots:   []*x509.Certificate{root.Certificate},
+			wantErr: true,
+		},
+		{
+			name:    "expired chain",
+			blob:    sign(t, expired.Key, nil, expired, intermediate),
+			roots:   []*x509.Certificate{root.Certificate},
+			wantErr: true,
+		},
+		{
+			name:    "chain without intermediate",
+			blob:    sign(t, leaf.Key, nil, leaf),
+			roots:   []*x509.Certificate{root.Certificate},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			payload, err := Parse([]byte(tt.blob), tt.roots, time.Now())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err == nil && payload.Number != 7 {
+				t.Errorf("no = %d, want 7", payload.Number)
+			}
+		})
+	}
+}
+
+func TestOutdated(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		nextUpdate string
+		want       bool
+	}{
+		{nextUpdate: "2026-11-01", want: false},
+		{nextUpdate: "2026-10-18", want: false},
+		{nextUpdate: "2026-10-17", want: true},
+		{nextUpdate: "", want: true},
+		{nextUpdate: "soon", want: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.nextUpdate, func(t *testing.T) {
+			if got := Outdated(&metadata.MetadataBLOBPayload{NextUpdate: tt.nextUpdate}, now); got != tt.want {
+				t.Errorf("outdated = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
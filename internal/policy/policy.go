@@ -0,0 +1,171 @@
+// Package policy decides which authenticators may be registered from their attestation.
+package policy
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-webauthn/webauthn/metadata"
+
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/enterprise"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/mds"
+)
+
+// Formats are the attestation statement formats the policy can name.
+var Formats = []string{"packed", "tpm", "android-key", "android-safetynet", "apple", "fido-u2f", "none"}
+
+// levels orders the certification levels. FIDO_CERTIFIED is the predecessor of FIDO_CERTIFIED_L1.
+var levels = map[metadata.AuthenticatorStatus]int{
+	metadata.FidoCertified:       1,
+	metadata.FidoCertifiedL1:     1,
+	metadata.FidoCertifiedL1plus: 2,
+	metadata.FidoCertifiedL2:     3,
+	metadata.FidoCertifiedL2plus: 4,
+	metadata.FidoCertifiedL3:     5,
+	metadata.FidoCertifiedL3plus: 6,
+}
+
+// IsCertificationLevel reports whether the status is a certification level.
+func IsCertificationLevel(status metadata.AuthenticatorStatus) bool {
+	_, ok := levels[status]
+
+	return ok
+}
+
+// Rules name the settings of Attestation. A Violation reports the rule which refused the authenticator.
+const (
+	RuleAllowedFormats        = "allowedFormats"
+	RuleDeniedFormats         = "deniedFormats"
+	RuleAllowedAAGUIDs        = "allowedAaguids"
+	RuleDeniedAAGUIDs         = "deniedAaguids"
+	RuleMinCertificationLevel = "minCertificationLevel"
+	RuleRejectUndesiredStatus = "rejectUndesiredStatus"
+	RuleRequireTrustAnchor    = "requireTrustAnchor"
+	RuleEnterpriseInventory   = "enterpriseInventory"
+)
+
+// Attestation is the declarative policy evaluated when a credential is registered. Empty settings allow anything.
+type Attestation struct {
+	// AllowedFormats, when not empty, are the only attestation formats accepted.
+	AllowedFormats []string
+	DeniedFormats  []string
+
+	// AllowedAAGUIDs, when not empty, are the only authenticator models accepted. AAGUIDs are lower case UUIDs.
+	AllowedAAGUIDs []string
+	DeniedAAGUIDs  []string
+
+	// MinCertificationLevel is the lowest FIDO certification status accepted, such as FIDO_CERTIFIED_L1. The
+	// authenticator must be found in the FIDO Metadata Service.
+	MinCertificationLevel metadata.AuthenticatorStatus
+
+	// RejectUndesiredStatus refuses authenticators whose latest status in the FIDO Metadata Service is undesired,
+	// such as REVOKED.
+	RejectUndesiredStatus bool
+
+	// RequireTrustAnchor refuses authenticators whose attestation certificate chain does not end in a trust anchor.
+	RequireTrustAnchor bool
+
+	// EnterpriseInventory, when not nil, refuses authenticators which are not identified by enterprise attestation
+	// as one of its devices.
+	EnterpriseInventory *enterprise.Inventory
+}
+
+// Subject is the authenticator being registered.
+type Subject struct {
+	Format string
+	AAGUID string
+
+	// Metadata is the entry of the authenticator in the FIDO Metadata Service, nil if it is not found.
+	Metadata *metadata.MetadataBLOBPayloadEntry
+
+	// TrustAnchor is the name of the trust anchor the attestation certificate chain ends in, empty if none.
+	TrustAnchor string
+	// TrustError is why the chain was not validated by a trust anchor.
+	TrustError error
+
+	// Device is the authenticator identified by enterprise attestation, nil if it is not identified.
+	Device *enterprise.Device
+}
+
+// Violation is the rule refusing an authenticator.
+type Violation struct {
+	Rule   string
+	Reason string
+}
+
+// Error implements error.
+func (v *Violation) Error() string {
+	return fmt.Sprintf("attestation policy %s is violated. %s", v.Rule, v.Reason)
+}
+
+// Evaluate returns a *Violation of the first rule refusing the subject, or nil if it is accepted.
+func (p Attestation) Evaluate(sub Subject) error {
+	if len(p.AllowedFormats) > 0 && !slices.Contains(p.AllowedFormats, sub.Format) {
+		return &Violation{Rule: RuleAllowedFormats, Reason: fmt.Sprintf("format %q is not allowed", sub.Format)}
+	}
+
+	if slices.Contains(p.DeniedFormats, sub.Format) {
+		return &Violation{Rule: RuleDeniedFormats, Reason: fmt.Sprintf("format %q is denied", sub.Format)}
+	}
+
+	aaguid := strings.ToLower(sub.AAGUID)
+
+	if len(p.AllowedAAGUIDs) > 0 && !slices.Contains(p.AllowedAAGUIDs, aaguid) {
+		return &Violation{Rule: RuleAllowedAAGUIDs, Reason: fmt.Sprintf("aaguid %s is not allowed", aaguid)}
+	}
+
+	if slices.Contains(p.DeniedAAGUIDs, aaguid) {
+		return &Violation{Rule: RuleDeniedAAGUIDs, Reason: fmt.Sprintf("aaguid %s is denied", aaguid)}
+	}
+
+	if p.MinCertificationLevel != "" {
+		if sub.Metadata == nil {
+			return &Violation{Rule: RuleMinCertificationLevel, Reason: fmt.Sprintf("aaguid %s is not found in metadata", aaguid)}
+		}
+
+		if level := certificationLevel(*sub.Metadata); levels[level] < levels[p.MinCertificationLevel] {
+			return &Violation{Rule: RuleMinCertificationLevel, Reason: fmt.Sprintf("certification level %q is lower than %s", level, p.MinCertificationLevel)}
+		}
+	}
+
+	if p.RejectUndesiredStatus && sub.Metadata != nil {
+		if status := mds.Status(*sub.Metadata); metadata.IsUndesiredAuthenticatorStatus(status) {
+			return &Violation{Rule: RuleRejectUndesiredStatus, Reason: fmt.Sprintf("status %s is undesired", status)}
+		}
+	}
+
+	if p.RequireTrustAnchor && sub.TrustAnchor == "" {
+		reason := "attestation is not validated by a trust anchor"
+		if sub.TrustError != nil {
+			reason = fmt.Sprintf("%s. error: %s", reason, sub.TrustError)
+		}
+
+		return &Violation{Rule: RuleRequireTrustAnchor, Reason: reason}
+	}
+
+	if p.EnterpriseInventory != nil {
+		if sub.Device == nil {
+			return &Violation{Rule: RuleEnterpriseInventory, Reason: "authenticator is not identified by enterprise attestation"}
+		}
+
+		if !p.EnterpriseInventory.Contains(*sub.Device) {
+			return &Violation{Rule: RuleEnterpriseInventory, Reason: fmt.Sprintf("device %s is not in the inventory", sub.Device.Serial)}
+		}
+	}
+
+	return nil
+}
+
+// certificationLevel returns the highest certification level the authenticator has reached.
+func certificationLevel(entry metadata.MetadataBLOBPayloadEntry) metadata.AuthenticatorStatus {
+	var level metadata.AuthenticatorStatus
+
+	for _, report := range entry.StatusReports {
+		if levels[report.Status] > levels[level] {
+			level = report.Status
+		}
+	}
+
+	return level
+}
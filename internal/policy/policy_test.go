@@ -0,0 +1,198 @@
+package policy
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/metadata"
+
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/enterprise"
+)
+
+const (
+	yubikey = "cb69481e-8ff7-4039-93ec-0a2729a154a8"
+	other   = "ee882879-721c-4913-9775-3dfcce97072a"
+)
+
+// entry returns a metadata entry with status reports of the statuses, in order of their effective dates.
+func entry(statuses ...metadata.AuthenticatorStatus) *metadata.MetadataBLOBPayloadEntry {
+	e := &metadata.MetadataBLOBPayloadEntry{AaGUID: yubikey}
+
+	for i, status := range statuses {
+		e.StatusReports = append(e.StatusReports, metadata.StatusReport{
+			Status:        status,
+			EffectiveDate: []string{"2020-01-01", "2021-01-01", "2022-01-01", "2023-01-01"}[i],
+		})
+	}
+
+	return e
+}
+
+func TestEvaluate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "inventory.txt")
+
+	if err := os.WriteFile(path, []byte("# approved\n0a:1b\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	inventory, err := enterprise.LoadInventory(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		policy   Attestation
+		subject  Subject
+		wantRule string
+	}{
+		{
+			name:    "empty policy",
+			subject: Subject{Format: "none", AAGUID: other},
+		},
+		{
+			name:    "allowed format",
+			policy:  Attestation{AllowedFormats: []string{"packed"}},
+			subject: Subject{Format: "packed"},
+		},
+		{
+			name:     "format not allowed",
+			policy:   Attestation{AllowedFormats: []string{"packed"}},
+			subject:  Subject{Format: "none"},
+			wantRule: RuleAllowedFormats,
+		},
+		{
+			name:     "denied format",
+			policy:   Attestation{DeniedFormats: []string{"none"}},
+			subject:  Subject{Format: "none"},
+			wantRule: RuleDeniedFormats,
+		},
+		{
+			name:    "allowed aaguid in upper case",
+			policy:  Attestation{AllowedAAGUIDs: []string{yubikey}},
+			subject: Subject{AAGUID: "CB69481E-8FF7-4039-93EC-0A2729A154A8"},
+		},
+		{
+			name:     "aaguid not allowed",
+			policy:   Attestation{AllowedAAGUIDs: []string{yubikey}},
+			subject:  Subject{AAGUID: other},
+			wantRule: RuleAllowedAAGUIDs,
+		},
+		{
+			name:     "denied aaguid",
+			policy:   Attestation{DeniedAAGUIDs: []string{yubikey}},
+			subject:  Subject{AAGUID: yubikey},
+			wantRule: RuleDeniedAAGUIDs,
+		},
+		{
+			name:     "certification level without metadata",
+			policy:   Attestation{MinCertificationLevel: metadata.FidoCertifiedL1},
+			subject:  Subject{AAGUID: yubikey},
+			wantRule: RuleMinCertificationLevel,
+		},
+		{
+			name:     "not certified",
+			policy:   Attestation{MinCertificationLevel: metadata.FidoCertifiedL1},
+			subject:  Subject{AAGUID: yubikey, Metadata: entry(metadata.NotFidoCertified)},
+			wantRule: RuleMinCertificationLevel,
+		},
+		{
+			name:    "same certification level",
+			policy:  Attestation{MinCertificationLevel: metadata.FidoCertifiedL1},
+			subject: Subject{AAGUID: yubikey, Metadata: entry(metadata.FidoCertifiedL1)},
+		},
+		{
+			name:    "FIDO_CERTIFIED is L1",
+			policy:  Attestation{MinCertificationLevel: metadata.FidoCertifiedL1},
+			subject: Subject{AAGUID: yubikey, Metadata: entry(metadata.FidoCertified)},
+		},
+		{
+			name:     "L1plus is lower than L2",
+			policy:   Attestation{MinCertificationLevel: metadata.FidoCertifiedL2},
+			subject:  Subject{AAGUID: yubikey, Metadata: entry(metadata.FidoCertifiedL1plus)},
+			wantRule: RuleMinCertificationLevel,
+		},
+		{
+			name:    "highest level of the reports",
+			policy:  Attestation{MinCertificationLevel: metadata.FidoCertifiedL2},
+			subject: Subject{AAGUID: yubikey, Metadata: entry(metadata.FidoCertifiedL1, metadata.FidoCertifiedL2, metadata.UpdateAvailable)},
+		},
+		{
+			name:     "undesired status",
+			policy:   Attestation{RejectUndesiredStatus: true},
+			subject:  Subject{AAGUID: yubikey, Metadata: entry(metadata.FidoCertifiedL1, metadata.Revoked)},
+			wantRule: RuleRejectUndesiredStatus,
+		},
+		{
+			name:    "undesired status fixed later",
+			policy:  Attestation{RejectUndesiredStatus: true},
+			subject: Subject{AAGUID: yubikey, Metadata: entry(metadata.UserVerificationBypass, metadata.UpdateAvailable)},
+		},
+		{
+			name:    "undesired status without metadata",
+			policy:  Attestation{RejectUndesiredStatus: true},
+			subject: Subject{AAGUID: yubikey},
+		},
+		{
+			name:    "trust anchor",
+			policy:  Attestation{RequireTrustAnchor: true},
+			subject: Subject{TrustAnchor: "yubico.pem: Yubico U2F Root CA"},
+		},
+		{
+			name:     "no trust anchor",
+			policy:   Attestation{RequireTrustAnchor: true},
+			subject:  Subject{TrustError: errors.New("no chain")},
+			wantRule: RuleRequireTrustAnchor,
+		},
+		{
+			name:    "device in the inventory",
+			policy:  Attestation{EnterpriseInventory: inventory},
+			subject: Subject{Device: &enterprise.Device{Serial: "0A1B"}},
+		},
+		{
+			name:     "device not in the inventory",
+			policy:   Attestation{EnterpriseInventory: inventory},
+			subject:  Subject{Device: &enterprise.Device{Serial: "0a1c"}},
+			wantRule: RuleEnterpriseInventory,
+		},
+		{
+			name:     "device not identified",
+			policy:   Attestation{EnterpriseInventory: inventory},
+			subject:  Subject{},
+			wantRule: RuleEnterpriseInventory,
+		},
+		{
+			name:     "first rule wins",
+			policy:   Attestation{DeniedFormats: []string{"none"}, DeniedAAGUIDs: []string{yubikey}},
+			subject:  Subject{Format: "none", AAGUID: yubikey},
+			wantRule: RuleDeniedFormats,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Evaluate(tt.subject)
+
+			if tt.wantRule == "" {
+				if err != nil {
+					t.Fatalf("error = %v, want nil", err)
+				}
+
+				return
+			}
+
+			var violation *Violation
+			if !errors.As(err, &violation) {
+				t.Fatalf("error = %v, want a violation of %s", err, tt.wantRule)
+			}
+
+			if violation.Rule != tt.wantRule {
+				t.Errorf("rule = %s, want %s", violation.Rule, tt.wantRule)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package replay
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrReplayed is returned when a challenge is presented for the second time.
+var ErrReplayed = errors.New("challenge has already been used")
+
+// Registry remembers consumed challenges until they expire so that each challenge is accepted only once.
+type Registry struct {
+	mu       sync.Mutex
+	consumed map[string]time.Time
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		consumed: map[string]time.Time{},
+	}
+}
+
+// Consume marks the challenge as used. The challenge is remembered until expiresAt, after which the session it
+// belongs to is rejected anyway. It returns ErrReplayed if the challenge has already been consumed.
+func (reg *Registry) Consume(challenge string, expiresAt time.Time) error {
+	now := time.Now()
+
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	if exp, ok := reg.consumed[challenge]; ok && now.Before(exp) {
+		return ErrReplayed
+	}
+
+	// NOTE: 登録のたびに期限切れのチャレンジを掃除する
+	for key, exp := range reg.consumed {
+		if !now.Before(exp) {
+			delete(reg.consumed, key)
+		}
+	}
+
+	reg.consumed[challenge] = expiresAt
+
+	return nil
+}
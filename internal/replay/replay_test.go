@@ -0,0 +1,123 @@
+package replay
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRegistryConsume(t *testing.T) {
+	type consume struct {
+		challenge string
+		expiresIn time.Duration
+		wantErr   error
+	}
+
+	tests := []struct {
+		name     string
+		consumes []consume
+	}{
+		{
+			name: "first use",
+			consumes: []consume{
+				{challenge: "a", expiresIn: time.Minute},
+			},
+		},
+		{
+			name: "replay",
+			consumes: []consume{
+				{challenge: "a", expiresIn: time.Minute},
+				{challenge: "a", expiresIn: time.Minute, wantErr: ErrReplayed},
+			},
+		},
+		{
+			name: "different challenges",
+			consumes: []consume{
+				{challenge: "a", expiresIn: time.Minute},
+				{challenge: "b", expiresIn: time.Minute},
+			},
+		},
+		{
+			name: "expired challenge is forgotten",
+			consumes: []consume{
+				{challenge: "a", expiresIn: -time.Second},
+				{challenge: "a", expiresIn: time.Minute},
+			},
+		},
+		{
+			name: "replay after another challenge is consumed",
+			consumes: []consume{
+				{challenge: "a", expiresIn: time.Minute},
+				{challenge: "b", expiresIn: -time.Second},
+				{challenge: "a", expiresIn: time.Minute, wantErr: ErrReplayed},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			reg := NewRegistry()
+
+			for i, c := range tt.consumes {
+				if err := reg.Consume(c.challenge, time.Now().Add(c.expiresIn)); !errors.Is(err, c.wantErr) {
+					t.Errorf("consume #%d of %q error = %v, want %v", i, c.challenge, err, c.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestRegistryPrunesExpired(t *testing.T) {
+	reg := NewRegistry()
+
+	if err := reg.Consume("expired", time.Now().Add(-time.Second)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := reg.Consume("alive", time.Now().Add(time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := reg.consumed["expired"]; ok {
+		t.Error("expired challenge is kept")
+	}
+
+	if _, ok := reg.consumed["alive"]; !ok {
+		t.Error("alive challenge is not kept")
+	}
+}
+
+func TestRegistryConcurrent(t *testing.T) {
+	reg := NewRegistry()
+
+	const n = 32
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		accepted int
+	)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := reg.Consume("challenge", time.Now().Add(time.Minute)); err == nil {
+				mu.Lock()
+				accepted++
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if accepted != 1 {
+		t.Errorf("accepted %d times, want once", accepted)
+	}
+}
@@ -0,0 +1,77 @@
+package sessionstore
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+var _ Store = (*Memory)(nil)
+
+// Memory is an in-memory Store.
+type Memory struct {
+	mu      sync.Mutex
+	entries map[string]entry
+}
+
+type entry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		entries: map[string]entry{},
+	}
+}
+
+// Save implements Store.
+func (mem *Memory) Save(ctx context.Context, id string, value []byte, ttl time.Duration) error {
+	now := time.Now()
+
+	mem.mu.Lock()
+	defer mem.mu.Unlock()
+
+	// NOTE: 保存のたびに期限切れのセッションを掃除する
+	for key, ent := range mem.entries {
+		if !now.Before(ent.expiresAt) {
+			delete(mem.entries, key)
+		}
+	}
+
+	mem.entries[id] = entry{
+		value:     value,
+		expiresAt: now.Add(ttl),
+	}
+
+	return nil
+}
+
+// Take implements Store.
+func (mem *Memory) Take(ctx context.Context, id string) ([]byte, error) {
+	mem.mu.Lock()
+	defer mem.mu.Unlock()
+
+	ent, ok := mem.entries[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	delete(mem.entries, id)
+
+	if !time.Now().Before(ent.expiresAt) {
+		return nil, ErrNotFound
+	}
+
+	return ent.value, nil
+}
+
+// Delete implements Store.
+func (mem *Memory) Delete(ctx context.Context, id string) error {
+	mem.mu.Lock()
+	defer mem.mu.Unlock()
+
+	delete(mem.entries, id)
+
+	return nil
+}
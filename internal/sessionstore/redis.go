@@ -0,0 +1,214 @@
+package sessionstore
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var _ Store = (*Redis)(nil)
+
+// Redis is a Store speaking the Redis protocol (RESP2). It needs GETDEL, available since Redis 6.2.
+type Redis struct {
+	addr   string
+	prefix string
+
+	mu   sync.Mutex
+	conn net.Conn
+	rd   *bufio.Reader
+}
+
+// NewRedis returns a Redis store connecting to addr lazily. Keys are prefixed with "session:".
+func NewRedis(addr string) *Redis {
+	return &Redis{
+		addr:   addr,
+		prefix: "session:",
+	}
+}
+
+// Save implements Store.
+func (rds *Redis) Save(ctx context.Context, id string, value []byte, ttl time.Duration) error {
+	if _, err := rds.do(ctx, "SET", rds.prefix+id, string(value), "PX", strconv.FormatInt(ttl.Milliseconds(), 10)); err != nil {
+		return fmt.Errorf("failed to set session. error: %w", err)
+	}
+
+	return nil
+}
+
+// Take implements Store.
+func (rds *Redis) Take(ctx context.Context, id string) ([]byte, error) {
+	reply, err := rds.do(ctx, "GETDEL", rds.prefix+id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to getdel session. error: %w", err)
+	}
+
+	value, ok := reply.([]byte)
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return value, nil
+}
+
+// Delete implements Store.
+func (rds *Redis) Delete(ctx context.Context, id string) error {
+	if _, err := rds.do(ctx, "DEL", rds.prefix+id); err != nil {
+		return fmt.Errorf("failed to del session. error: %w", err)
+	}
+
+	return nil
+}
+
+// Close closes the connection.
+func (rds *Redis) Close() error {
+	rds.mu.Lock()
+	defer rds.mu.Unlock()
+
+	if rds.conn == nil {
+		return nil
+	}
+
+	err := rds.conn.Close()
+
+	rds.conn = nil
+
+	return err
+}
+
+// do sends a command and reads its reply. A connection that failed is dropped and dialed again by the next command.
+func (rds *Redis) do(ctx context.Context, args ...string) (any, error) {
+	rds.mu.Lock()
+	defer rds.mu.Unlock()
+
+	if rds.conn == nil {
+		var dialer net.Dialer
+
+		conn, err := dialer.DialContext(ctx, "tcp", rds.addr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dial %s. error: %w", rds.addr, err)
+		}
+
+		rds.conn = conn
+		rds.rd = bufio.NewReader(conn)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(5 * time.Second)
+	}
+
+	if err := rds.conn.SetDeadline(deadline); err != nil {
+		return nil, rds.drop(err)
+	}
+
+	if _, err := rds.conn.Write(encodeCommand(args)); err != nil {
+		return nil, rds.drop(err)
+	}
+
+	reply, err := readReply(rds.rd)
+	if err != nil {
+		var rerr Error
+		if errors.As(err, &rerr) {
+			return nil, err
+		}
+
+		return nil, rds.drop(err)
+	}
+
+	return reply, nil
+}
+
+func (rds *Redis) drop(err error) error {
+	rds.conn.Close()
+
+	rds.conn = nil
+
+	return err
+}
+
+// Error is an error reply sent by the server.
+type Error string
+
+func (e Error) Error() string {
+	return string(e)
+}
+
+func encodeCommand(args []string) []byte {
+	buf := []byte("*" + strconv.Itoa(len(args)) + "\r\n")
+
+	for _, arg := range args {
+		buf = append(buf, "$"+strconv.Itoa(len(arg))+"\r\n"...)
+		buf = append(buf, arg...)
+		buf = append(buf, "\r\n"...)
+	}
+
+	return buf
+}
+
+// readReply reads a RESP2 reply. Bulk strings are returned as []byte, nil bulk strings and arrays as nil,
+// simple strings as string, integers as int64 and arrays as []any.
+func readReply(rd *bufio.Reader) (any, error) {
+	line, err := rd.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("malformed reply %q", line)
+	}
+
+	kind, body := line[0], line[1:len(line)-2]
+
+	switch kind {
+	case '+':
+		return body, nil
+	case '-':
+		return nil, Error(body)
+	case ':':
+		return strconv.ParseInt(body, 10, 64)
+	case '$':
+		size, err := strconv.Atoi(body)
+		if err != nil {
+			return nil, err
+		}
+
+		if size < 0 {
+			return nil, nil
+		}
+
+		buf := make([]byte, size+2)
+
+		if _, err := io.ReadFull(rd, buf); err != nil {
+			return nil, err
+		}
+
+		return buf[:size], nil
+	case '*':
+		size, err := strconv.Atoi(body)
+		if err != nil {
+			return nil, err
+		}
+
+		if size < 0 {
+			return nil, nil
+		}
+
+		items := make([]any, size)
+
+		for i := range items {
+			if items[i], err = readReply(rd); err != nil {
+				return nil, err
+			}
+		}
+
+		return items, nil
+	default:
+		return nil, fmt.Errorf("unknown reply type %q", kind)
+	}
+}
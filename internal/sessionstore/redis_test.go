@@ -0,0 +1,196 @@
+package sessionstore_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/sessionstore"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/sessionstore/redistest"
+)
+
+func newRedis(t *testing.T) (*sessionstore.Redis, *redistest.Server) {
+	t.Helper()
+
+	srv, err := redistest.NewServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rds := sessionstore.NewRedis(srv.Addr)
+
+	t.Cleanup(func() {
+		rds.Close()
+		srv.Close()
+	})
+
+	return rds, srv
+}
+
+func TestStore(t *testing.T) {
+	stores := []struct {
+		name  string
+		store func(t *testing.T) sessionstore.Store
+	}{
+		{
+			name: "memory",
+			store: func(t *testing.T) sessionstore.Store {
+				return sessionstore.NewMemory()
+			},
+		},
+		{
+			name: "redis",
+			store: func(t *testing.T) sessionstore.Store {
+				rds, _ := newRedis(t)
+
+				return rds
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+		run  func(t *testing.T, ctx context.Context, store sessionstore.Store)
+	}{
+		{
+			name: "take returns the saved value once",
+			run: func(t *testing.T, ctx context.Context, store sessionstore.Store) {
+				if err := store.Save(ctx, "id", []byte("value"), time.Minute); err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := store.Take(ctx, "id")
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !bytes.Equal(got, []byte("value")) {
+					t.Errorf("value = %q, want %q", got, "value")
+				}
+
+				if _, err := store.Take(ctx, "id"); !errors.Is(err, sessionstore.ErrNotFound) {
+					t.Errorf("second take error = %v, want %v", err, sessionstore.ErrNotFound)
+				}
+			},
+		},
+		{
+			name: "take of an unknown id",
+			run: func(t *testing.T, ctx context.Context, store sessionstore.Store) {
+				if _, err := store.Take(ctx, "unknown"); !errors.Is(err, sessionstore.ErrNotFound) {
+					t.Errorf("error = %v, want %v", err, sessionstore.ErrNotFound)
+				}
+			},
+		},
+		{
+			name: "save overwrites",
+			run: func(t *testing.T, ctx context.Context, store sessionstore.Store) {
+				if err := store.Save(ctx, "id", []byte("first"), time.Minute); err != nil {
+					t.Fatal(err)
+				}
+
+				if err := store.Save(ctx, "id", []byte("second"), time.Minute); err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := store.Take(ctx, "id")
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !bytes.Equal(got, []byte("second")) {
+					t.Errorf("value = %q, want %q", got, "second")
+				}
+			},
+		},
+		{
+			name: "delete",
+			run: func(t *testing.T, ctx context.Context, store sessionstore.Store) {
+				if err := store.Save(ctx, "id", []byte("value"), time.Minute); err != nil {
+					t.Fatal(err)
+				}
+
+				if err := store.Delete(ctx, "id"); err != nil {
+					t.Fatal(err)
+				}
+
+				if err := store.Delete(ctx, "unknown"); err != nil {
+					t.Fatalf("delete of an unknown id. error: %v", err)
+				}
+
+				if _, err := store.Take(ctx, "id"); !errors.Is(err, sessionstore.ErrNotFound) {
+					t.Errorf("error = %v, want %v", err, sessionstore.ErrNotFound)
+				}
+			},
+		},
+		{
+			name: "expiry",
+			run: func(t *testing.T, ctx context.Context, store sessionstore.Store) {
+				if err := store.Save(ctx, "id", []byte("value"), 50*time.Millisecond); err != nil {
+					t.Fatal(err)
+				}
+
+				time.Sleep(100 * time.Millisecond)
+
+				if _, err := store.Take(ctx, "id"); !errors.Is(err, sessionstore.ErrNotFound) {
+					t.Errorf("error = %v, want %v", err, sessionstore.ErrNotFound)
+				}
+			},
+		},
+	}
+
+	for _, st := range stores {
+		st := st
+
+		for _, tt := range tests {
+			tt := tt
+
+			t.Run(st.name+"/"+tt.name, func(t *testing.T) {
+				tt.run(t, context.Background(), st.store(t))
+			})
+		}
+	}
+}
+
+func TestRedisReconnect(t *testing.T) {
+	ctx := context.Background()
+
+	rds, srv := newRedis(t)
+
+	if err := rds.Save(ctx, "id", []byte("value"), time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	srv.DropConnections()
+
+	// NOTE: 切断に気づくのは次のコマンドなので、そのコマンドは失敗してコネクションが捨てられる
+	if _, err := rds.Take(ctx, "id"); err == nil {
+		t.Fatal("expected an error on the dropped connection")
+	}
+
+	got, err := rds.Take(ctx, "id")
+	if err != nil {
+		t.Fatalf("failed to take after reconnecting. error: %v", err)
+	}
+
+	if !bytes.Equal(got, []byte("value")) {
+		t.Errorf("value = %q, want %q", got, "value")
+	}
+
+	if n := srv.Len(); n != 0 {
+		t.Errorf("keys = %d, want 0 after take", n)
+	}
+}
+
+func TestRedisServerDown(t *testing.T) {
+	ctx := context.Background()
+
+	rds, srv := newRedis(t)
+
+	srv.Close()
+
+	if err := rds.Save(ctx, "id", []byte("value"), time.Minute); err == nil {
+		t.Fatal("expected an error without the server")
+	}
+}
@@ -0,0 +1,264 @@
+// Package redistest provides an in-process server speaking enough of the Redis protocol to exercise sessionstore.Redis
+// without a real Redis.
+package redistest
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Server is a fake Redis server supporting PING, SET (with EX/PX), GET, GETDEL and DEL.
+type Server struct {
+	// Addr is the address the server listens on.
+	Addr string
+
+	ln net.Listener
+	wg sync.WaitGroup
+
+	mu      sync.Mutex
+	conns   map[net.Conn]struct{}
+	entries map[string]entry
+}
+
+type entry struct {
+	value     string
+	expiresAt time.Time
+}
+
+// NewServer starts a server listening on a random local port.
+func NewServer() (*Server, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen. error: %w", err)
+	}
+
+	srv := &Server{
+		Addr:    ln.Addr().String(),
+		ln:      ln,
+		conns:   map[net.Conn]struct{}{},
+		entries: map[string]entry{},
+	}
+
+	srv.wg.Add(1)
+
+	go srv.serve()
+
+	return srv, nil
+}
+
+// Close stops the server, closes open connections and waits for them to finish.
+func (srv *Server) Close() error {
+	err := srv.ln.Close()
+
+	srv.mu.Lock()
+	for conn := range srv.conns {
+		conn.Close()
+	}
+	srv.mu.Unlock()
+
+	srv.wg.Wait()
+
+	return err
+}
+
+// DropConnections closes the open connections while keeping the listener and the keys, as a restarted proxy or an
+// idle timeout would.
+func (srv *Server) DropConnections() {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	for conn := range srv.conns {
+		conn.Close()
+	}
+}
+
+// Len returns the number of unexpired keys.
+func (srv *Server) Len() int {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	n := 0
+
+	for _, ent := range srv.entries {
+		if ent.alive(time.Now()) {
+			n++
+		}
+	}
+
+	return n
+}
+
+func (srv *Server) serve() {
+	defer srv.wg.Done()
+
+	for {
+		conn, err := srv.ln.Accept()
+		if err != nil {
+			return
+		}
+
+		srv.mu.Lock()
+		srv.conns[conn] = struct{}{}
+		srv.mu.Unlock()
+
+		srv.wg.Add(1)
+
+		go func() {
+			defer srv.wg.Done()
+
+			srv.handle(conn)
+
+			srv.mu.Lock()
+			delete(srv.conns, conn)
+			srv.mu.Unlock()
+
+			conn.Close()
+		}()
+	}
+}
+
+func (srv *Server) handle(conn net.Conn) {
+	rd := bufio.NewReader(conn)
+
+	for {
+		args, err := readCommand(rd)
+		if err != nil {
+			return
+		}
+
+		if _, err := io.WriteString(conn, srv.exec(args)); err != nil {
+			return
+		}
+	}
+}
+
+func (srv *Server) exec(args []string) string {
+	if len(args) == 0 {
+		return "-ERR empty command\r\n"
+	}
+
+	now := time.Now()
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	switch cmd := strings.ToUpper(args[0]); {
+	case cmd == "PING":
+		return "+PONG\r\n"
+	case cmd == "SET" && (len(args) == 3 || len(args) == 5):
+		ent := entry{
+			value: args[2],
+		}
+
+		if len(args) == 5 {
+			n, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil || n <= 0 {
+				return "-ERR invalid expire time\r\n"
+			}
+
+			switch strings.ToUpper(args[3]) {
+			case "EX":
+				ent.expiresAt = now.Add(time.Duration(n) * time.Second)
+			case "PX":
+				ent.expiresAt = now.Add(time.Duration(n) * time.Millisecond)
+			default:
+				return "-ERR syntax error\r\n"
+			}
+		}
+
+		srv.entries[args[1]] = ent
+
+		return "+OK\r\n"
+	case (cmd == "GET" || cmd == "GETDEL") && len(args) == 2:
+		ent, ok := srv.entries[args[1]]
+		if !ok || !ent.alive(now) {
+			delete(srv.entries, args[1])
+
+			return "$-1\r\n"
+		}
+
+		if cmd == "GETDEL" {
+			delete(srv.entries, args[1])
+		}
+
+		return "$" + strconv.Itoa(len(ent.value)) + "\r\n" + ent.value + "\r\n"
+	case cmd == "DEL" && len(args) >= 2:
+		n := 0
+
+		for _, key := range args[1:] {
+			if ent, ok := srv.entries[key]; ok && ent.alive(now) {
+				n++
+			}
+
+			delete(srv.entries, key)
+		}
+
+		return ":" + strconv.Itoa(n) + "\r\n"
+	default:
+		return "-ERR unknown command or wrong number of arguments for '" + args[0] + "'\r\n"
+	}
+}
+
+func (ent entry) alive(now time.Time) bool {
+	return ent.expiresAt.IsZero() || now.Before(ent.expiresAt)
+}
+
+// readCommand reads a command sent as a RESP array of bulk strings.
+func readCommand(rd *bufio.Reader) ([]string, error) {
+	line, err := readLine(rd)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(line, "*") {
+		return nil, fmt.Errorf("expected array, got %q", line)
+	}
+
+	n, err := strconv.Atoi(line[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]string, n)
+
+	for i := range args {
+		line, err := readLine(rd)
+		if err != nil {
+			return nil, err
+		}
+
+		if !strings.HasPrefix(line, "$") {
+			return nil, fmt.Errorf("expected bulk string, got %q", line)
+		}
+
+		size, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, err
+		}
+
+		buf := make([]byte, size+2)
+
+		if _, err := io.ReadFull(rd, buf); err != nil {
+			return nil, err
+		}
+
+		args[i] = string(buf[:size])
+	}
+
+	return args, nil
+}
+
+func readLine(rd *bufio.Reader) (string, error) {
+	line, err := rd.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(line, "\r\n"), nil
+}
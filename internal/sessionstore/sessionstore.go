@@ -0,0 +1,20 @@
+package sessionstore
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var ErrNotFound = errors.New("not found")
+
+// Store keeps ceremony sessions on the server so that only an opaque id is handed to the browser.
+type Store interface {
+	// Save stores the value under id. The value is discarded after ttl.
+	Save(ctx context.Context, id string, value []byte, ttl time.Duration) error
+	// Take returns the value stored under id and deletes it, so that a session can be used only once.
+	// It returns ErrNotFound if there is no such value or it has expired.
+	Take(ctx context.Context, id string) ([]byte, error)
+	// Delete deletes the value stored under id if any.
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,169 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+var _ CredentialStore = (*File)(nil)
+
+// File is a CredentialStore that keeps its data in memory and writes every change to a JSON file.
+type File struct {
+	mu   sync.Mutex
+	path string
+	mem  *Memory
+}
+
+// NewFile opens the store at path, loading any data written by a previous run.
+func NewFile(path string) (*File, error) {
+	mem := NewMemory()
+
+	buf, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return nil, fmt.Errorf("failed to read %s. error: %w", path, err)
+	default:
+		var snap snapshot
+
+		if err := json.Unmarshal(buf, &snap); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s. error: %w", path, err)
+		}
+
+		mem.restore(snap)
+	}
+
+	return &File{
+		path: path,
+		mem:  mem,
+	}, nil
+}
+
+// CreateUser implements CredentialStore.
+func (fl *File) CreateUser(ctx context.Context, user User) error {
+	return fl.apply(func() error {
+		return fl.mem.CreateUser(ctx, user)
+	})
+}
+
+// FindUser implements CredentialStore.
+func (fl *File) FindUser(ctx context.Context, id []byte) (*User, error) {
+	return fl.mem.FindUser(ctx, id)
+}
+
+// FindUserByName implements CredentialStore.
+func (fl *File) FindUserByName(ctx context.Context, name string) (*User, error) {
+	return fl.mem.FindUserByName(ctx, name)
+}
+
+// CreateCredential implements CredentialStore.
+func (fl *File) CreateCredential(ctx context.Context, cred Credential) error {
+	return fl.apply(func() error {
+		return fl.mem.CreateCredential(ctx, cred)
+	})
+}
+
+// FindCredential implements CredentialStore.
+func (fl *File) FindCredential(ctx context.Context, id []byte) (*Credential, error) {
+	return fl.mem.FindCredential(ctx, id)
+}
+
+// UpdateCredential implements CredentialStore.
+func (fl *File) UpdateCredential(ctx context.Context, id []byte, update func(cred *Credential) error) error {
+	return fl.apply(func() error {
+		return fl.mem.UpdateCredential(ctx, id, update)
+	})
+}
+
+// UpdateCredentialWithOthers implements CredentialStore.
+func (fl *File) UpdateCredentialWithOthers(ctx context.Context, id []byte, update func(cred *Credential, others []Credential) error) error {
+	return fl.apply(func() error {
+		return fl.mem.UpdateCredentialWithOthers(ctx, id, update)
+	})
+}
+
+// DeleteCredential implements CredentialStore.
+func (fl *File) DeleteCredential(ctx context.Context, id []byte, check func(cred *Credential, others []Credential) error) error {
+	return fl.apply(func() error {
+		return fl.mem.DeleteCredential(ctx, id, check)
+	})
+}
+
+// ListCredentials implements CredentialStore.
+func (fl *File) ListCredentials(ctx context.Context, userID []byte) ([]Credential, error) {
+	return fl.mem.ListCredentials(ctx, userID)
+}
+
+// apply changes the store in memory and writes it to the file. The change is rolled back when the file cannot be
+// written so that the memory never holds data which would be lost on restart.
+func (fl *File) apply(change func() error) error {
+	fl.mu.Lock()
+	defer fl.mu.Unlock()
+
+	prev := fl.mem.snapshot()
+
+	if err := change(); err != nil {
+		return err
+	}
+
+	if err := fl.flush(); err != nil {
+		fl.mem.restore(prev)
+
+		return err
+	}
+
+	return nil
+}
+
+// flush writes the whole store to a temporary file and renames it over the previous one
+// so that a crash never leaves a half written file behind.
+func (fl *File) flush() error {
+	buf, err := json.MarshalIndent(fl.mem.snapshot(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal store. error: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(fl.path), 0o700); err != nil {
+		return fmt.Errorf("failed to create directory. error: %w", err)
+	}
+
+	tmp := fl.path + ".tmp"
+
+	if err := writeFile(tmp, buf); err != nil {
+		return fmt.Errorf("failed to write %s. error: %w", tmp, err)
+	}
+
+	if err := os.Rename(tmp, fl.path); err != nil {
+		return fmt.Errorf("failed to rename %s. error: %w", tmp, err)
+	}
+
+	return nil
+}
+
+// writeFile writes buf to path and syncs it to the disk before it is renamed, as the rename alone may reach the disk
+// before the data.
+func writeFile(path string, buf []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(buf); err != nil {
+		f.Close()
+
+		return err
+	}
+
+	if err := f.Sync(); err != nil {
+		f.Close()
+
+		return err
+	}
+
+	return f.Close()
+}
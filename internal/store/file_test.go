@@ -0,0 +1,120 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
+func TestFileRollback(t *testing.T) {
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "store.json")
+
+	fl, err := NewFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	user := User{ID: []byte("user"), Name: "alice"}
+
+	if err := fl.CreateUser(ctx, user); err != nil {
+		t.Fatal(err)
+	}
+
+	cred := Credential{UserID: user.ID, Credential: webauthn.Credential{ID: []byte("credential")}}
+
+	if err := fl.CreateCredential(ctx, cred); err != nil {
+		t.Fatal(err)
+	}
+
+	// NOTE: ファイルの場所をディレクトリにして書き込みを失敗させる
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Mkdir(path, 0o700); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		change func() error
+	}{
+		{
+			name: "create user",
+			change: func() error {
+				return fl.CreateUser(ctx, User{ID: []byte("other"), Name: "bob"})
+			},
+		},
+		{
+			name: "create credential",
+			change: func() error {
+				return fl.CreateCredential(ctx, Credential{UserID: user.ID, Credential: webauthn.Credential{ID: []byte("other")}})
+			},
+		},
+		{
+			name: "update credential",
+			change: func() error {
+				return fl.UpdateCredential(ctx, cred.Credential.ID, func(cred *Credential) error {
+					cred.Nickname = "renamed"
+
+					return nil
+				})
+			},
+		},
+		{
+			name: "update credential with others",
+			change: func() error {
+				return fl.UpdateCredentialWithOthers(ctx, cred.Credential.ID, func(cred *Credential, others []Credential) error {
+					cred.Nickname = "renamed"
+
+					return nil
+				})
+			},
+		},
+		{
+			name: "delete credential",
+			change: func() error {
+				return fl.DeleteCredential(ctx, cred.Credential.ID, func(*Credential, []Credential) error {
+					return nil
+				})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.change(); err == nil {
+				t.Fatal("expected an error when the file cannot be written")
+			}
+
+			if _, err := fl.FindUserByName(ctx, "bob"); !errors.Is(err, ErrNotFound) {
+				t.Errorf("user is kept after the failed write. error: %v", err)
+			}
+
+			if _, err := fl.FindCredential(ctx, []byte("other")); !errors.Is(err, ErrNotFound) {
+				t.Errorf("credential is kept after the failed write. error: %v", err)
+			}
+
+			got, err := fl.FindCredential(ctx, cred.Credential.ID)
+			if err != nil {
+				t.Fatalf("credential is lost after the failed write. error: %v", err)
+			}
+
+			if got.Nickname != "" {
+				t.Errorf("nickname = %q, want the nickname before the failed write", got.Nickname)
+			}
+
+			if _, err := fl.FindUserByName(ctx, user.Name); err != nil {
+				t.Errorf("user is lost after the failed write. error: %v", err)
+			}
+		})
+	}
+}
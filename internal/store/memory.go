@@ -0,0 +1,240 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+var _ CredentialStore = (*Memory)(nil)
+
+// Memory is an in-memory CredentialStore.
+type Memory struct {
+	mu          sync.RWMutex
+	users       map[string]User
+	names       map[string]string
+	credentials map[string]Credential
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		users:       map[string]User{},
+		names:       map[string]string{},
+		credentials: map[string]Credential{},
+	}
+}
+
+// CreateUser implements CredentialStore.
+func (mem *Memory) CreateUser(ctx context.Context, user User) error {
+	mem.mu.Lock()
+	defer mem.mu.Unlock()
+
+	if _, ok := mem.users[string(user.ID)]; ok {
+		return ErrAlreadyExists
+	}
+
+	if _, ok := mem.names[user.Name]; ok {
+		return ErrAlreadyExists
+	}
+
+	mem.users[string(user.ID)] = user
+	mem.names[user.Name] = string(user.ID)
+
+	return nil
+}
+
+// FindUser implements CredentialStore.
+func (mem *Memory) FindUser(ctx context.Context, id []byte) (*User, error) {
+	mem.mu.RLock()
+	defer mem.mu.RUnlock()
+
+	user, ok := mem.users[string(id)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return &user, nil
+}
+
+// FindUserByName implements CredentialStore.
+func (mem *Memory) FindUserByName(ctx context.Context, name string) (*User, error) {
+	mem.mu.RLock()
+	defer mem.mu.RUnlock()
+
+	id, ok := mem.names[name]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	user := mem.users[id]
+
+	return &user, nil
+}
+
+// CreateCredential implements CredentialStore.
+func (mem *Memory) CreateCredential(ctx context.Context, cred Credential) error {
+	mem.mu.Lock()
+	defer mem.mu.Unlock()
+
+	if _, ok := mem.credentials[string(cred.Credential.ID)]; ok {
+		return ErrAlreadyExists
+	}
+
+	if cred.CreatedAt.IsZero() {
+		cred.CreatedAt = time.Now()
+	}
+
+	mem.credentials[string(cred.Credential.ID)] = cred
+
+	return nil
+}
+
+// FindCredential implements CredentialStore.
+func (mem *Memory) FindCredential(ctx context.Context, id []byte) (*Credential, error) {
+	mem.mu.RLock()
+	defer mem.mu.RUnlock()
+
+	cred, ok := mem.credentials[string(id)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return &cred, nil
+}
+
+// UpdateCredential implements CredentialStore.
+func (mem *Memory) UpdateCredential(ctx context.Context, id []byte, update func(cred *Credential) error) error {
+	mem.mu.Lock()
+	defer mem.mu.Unlock()
+
+	cred, ok := mem.credentials[string(id)]
+	if !ok {
+		return ErrNotFound
+	}
+
+	if err := update(&cred); err != nil {
+		return err
+	}
+
+	mem.credentials[string(id)] = cred
+
+	return nil
+}
+
+// UpdateCredentialWithOthers implements CredentialStore.
+func (mem *Memory) UpdateCredentialWithOthers(ctx context.Context, id []byte, update func(cred *Credential, others []Credential) error) error {
+	mem.mu.Lock()
+	defer mem.mu.Unlock()
+
+	cred, ok := mem.credentials[string(id)]
+	if !ok {
+		return ErrNotFound
+	}
+
+	if err := update(&cred, mem.others(cred)); err != nil {
+		return err
+	}
+
+	mem.credentials[string(id)] = cred
+
+	return nil
+}
+
+// DeleteCredential implements CredentialStore.
+func (mem *Memory) DeleteCredential(ctx context.Context, id []byte, check func(cred *Credential, others []Credential) error) error {
+	mem.mu.Lock()
+	defer mem.mu.Unlock()
+
+	cred, ok := mem.credentials[string(id)]
+	if !ok {
+		return ErrNotFound
+	}
+
+	if err := check(&cred, mem.others(cred)); err != nil {
+		return err
+	}
+
+	delete(mem.credentials, string(id))
+
+	return nil
+}
+
+// others returns the credentials of the owner of cred except cred. The caller must hold the lock.
+func (mem *Memory) others(cred Credential) []Credential {
+	others := make([]Credential, 0)
+
+	for key, c := range mem.credentials {
+		if key != string(cred.Credential.ID) && bytes.Equal(c.UserID, cred.UserID) {
+			others = append(others, c)
+		}
+	}
+
+	return others
+}
+
+// ListCredentials implements CredentialStore.
+func (mem *Memory) ListCredentials(ctx context.Context, userID []byte) ([]Credential, error) {
+	mem.mu.RLock()
+	defer mem.mu.RUnlock()
+
+	creds := make([]Credential, 0)
+
+	for _, cred := range mem.credentials {
+		if bytes.Equal(cred.UserID, userID) {
+			creds = append(creds, cred)
+		}
+	}
+
+	sort.Slice(creds, func(i, j int) bool {
+		return creds[i].CreatedAt.Before(creds[j].CreatedAt)
+	})
+
+	return creds, nil
+}
+
+// snapshot is the serialized form of the store.
+type snapshot struct {
+	Users       []User       `json:"users"`
+	Credentials []Credential `json:"credentials"`
+}
+
+func (mem *Memory) snapshot() snapshot {
+	mem.mu.RLock()
+	defer mem.mu.RUnlock()
+
+	snap := snapshot{
+		Users:       make([]User, 0, len(mem.users)),
+		Credentials: make([]Credential, 0, len(mem.credentials)),
+	}
+
+	for _, user := range mem.users {
+		snap.Users = append(snap.Users, user)
+	}
+
+	for _, cred := range mem.credentials {
+		snap.Credentials = append(snap.Credentials, cred)
+	}
+
+	return snap
+}
+
+// restore replaces the contents of the store with the snapshot.
+func (mem *Memory) restore(snap snapshot) {
+	mem.mu.Lock()
+	defer mem.mu.Unlock()
+
+	mem.users = make(map[string]User, len(snap.Users))
+	mem.names = make(map[string]string, len(snap.Users))
+	mem.credentials = make(map[string]Credential, len(snap.Credentials))
+
+	for _, user := range snap.Users {
+		mem.users[string(user.ID)] = user
+		mem.names[user.Name] = string(user.ID)
+	}
+
+	for _, cred := range snap.Credentials {
+		mem.credentials[string(cred.Credential.ID)] = cred
+	}
+}
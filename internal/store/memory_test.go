@@ -0,0 +1,82 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
+func TestMemoryUpdateCredentialWithOthers(t *testing.T) {
+	ctx := context.Background()
+
+	mem := NewMemory()
+
+	errTaken := errors.New("taken by another credential")
+
+	ids := [][]byte{[]byte("first"), []byte("second"), []byte("third")}
+
+	for _, id := range ids {
+		if err := mem.CreateCredential(ctx, Credential{UserID: []byte("alice"), Credential: webauthn.Credential{ID: id}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := mem.CreateCredential(ctx, Credential{UserID: []byte("bob"), Credential: webauthn.Credential{ID: []byte("bob"), AttestationType: "taken"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	// NOTE: 他のクレデンシャルに印がなければ印を付ける更新を同時に行い、一つだけが成功することを確かめる
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+	)
+
+	for _, id := range ids {
+		id := id
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			err := mem.UpdateCredentialWithOthers(ctx, id, func(cred *Credential, others []Credential) error {
+				if len(others) != len(ids)-1 {
+					t.Errorf("others = %d credentials, want %d", len(others), len(ids)-1)
+				}
+
+				for _, other := range others {
+					if other.Credential.AttestationType == "taken" {
+						return errTaken
+					}
+				}
+
+				cred.Credential.AttestationType = "taken"
+
+				return nil
+			})
+			if err != nil && !errors.Is(err, errTaken) {
+				t.Error(err)
+			}
+
+			if err == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if succeeded != 1 {
+		t.Errorf("%d updates succeeded, want 1", succeeded)
+	}
+
+	if err := mem.UpdateCredentialWithOthers(ctx, []byte("unknown"), func(*Credential, []Credential) error { return nil }); !errors.Is(err, ErrNotFound) {
+		t.Errorf("error = %v, want %v", err, ErrNotFound)
+	}
+}
@@ -0,0 +1,90 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/extension"
+)
+
+var (
+	ErrNotFound      = errors.New("not found")
+	ErrAlreadyExists = errors.New("already exists")
+)
+
+// User is a user account that owns credentials.
+type User struct {
+	ID          []byte `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+// Credential is a webauthn.Credential with the user who owns it.
+type Credential struct {
+	UserID     []byte              `json:"userId"`
+	Credential webauthn.Credential `json:"credential"`
+	CreatedAt  time.Time           `json:"createdAt"`
+	LastUsedAt time.Time           `json:"lastUsedAt,omitempty"`
+
+	// Nickname is the name the user gave to the credential.
+	Nickname string `json:"nickname,omitempty"`
+
+	// TrustAnchor is the name of the root the attestation certificate chain was validated by, empty if none.
+	TrustAnchor string `json:"trustAnchor,omitempty"`
+
+	// DeviceSerial and DeviceFingerprint identify the authenticator registered with enterprise attestation. They are
+	// the serial number and the SHA-256 fingerprint of the attestation certificate in hex.
+	DeviceSerial      string `json:"deviceSerial,omitempty"`
+	DeviceFingerprint string `json:"deviceFingerprint,omitempty"`
+
+	// AttestationObject and ClientDataJSON are the raw registration response kept to debug authenticators with.
+	AttestationObject []byte `json:"attestationObject,omitempty"`
+	ClientDataJSON    []byte `json:"clientDataJson,omitempty"`
+
+	// Extensions is the extension outputs returned at registration.
+	Extensions extension.Outputs `json:"extensions"`
+
+	// PRFSalt is the salt the prf extension is evaluated with at login. The derived secret is only known to the
+	// client.
+	PRFSalt []byte `json:"prfSalt,omitempty"`
+
+	// VaultKey is the data encryption key of the user's vault wrapped with the prf output of this credential, nil if
+	// it has not been wrapped for this credential yet.
+	VaultKey *VaultKey `json:"vaultKey,omitempty"`
+}
+
+// VaultKey is a data encryption key wrapped by the client. The server cannot unwrap it.
+type VaultKey struct {
+	// KeyID is chosen by the client to tell the copies of the same data encryption key apart from other keys.
+	KeyID     string    `json:"keyId"`
+	Wrapped   []byte    `json:"wrapped"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// CredentialStore stores users and their credentials.
+type CredentialStore interface {
+	// CreateUser stores a new user or returns ErrAlreadyExists if the id or the name is already taken.
+	CreateUser(ctx context.Context, user User) error
+	// FindUser returns the user identified by id or ErrNotFound.
+	FindUser(ctx context.Context, id []byte) (*User, error)
+	// FindUserByName returns the user with the name or ErrNotFound.
+	FindUserByName(ctx context.Context, name string) (*User, error)
+	// CreateCredential stores a new credential or returns ErrAlreadyExists if the credential id is already taken.
+	CreateCredential(ctx context.Context, cred Credential) error
+	// FindCredential returns the credential identified by id or ErrNotFound.
+	FindCredential(ctx context.Context, id []byte) (*Credential, error)
+	// UpdateCredential applies update to the credential identified by id atomically or returns ErrNotFound.
+	// The credential is left unchanged when update returns an error.
+	UpdateCredential(ctx context.Context, id []byte, update func(cred *Credential) error) error
+	// UpdateCredentialWithOthers is UpdateCredential whose update also sees the other credentials of the owner, so
+	// that a change depending on them cannot race with changes to them.
+	UpdateCredentialWithOthers(ctx context.Context, id []byte, update func(cred *Credential, others []Credential) error) error
+	// DeleteCredential deletes the credential identified by id or returns ErrNotFound. check is called atomically
+	// with the other credentials of the owner, and the credential is kept when check returns an error.
+	DeleteCredential(ctx context.Context, id []byte, check func(cred *Credential, others []Credential) error) error
+	// ListCredentials returns the credentials owned by the user in creation order.
+	ListCredentials(ctx context.Context, userID []byte) ([]Credential, error)
+}
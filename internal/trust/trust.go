@@ -0,0 +1,157 @@
+// Package trust verifies the attestation certificate chains (x5c) of authenticators against trusted roots.
+package trust
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ErrNoChain is returned for attestation statements without x5c, such as none and self attestation.
+var ErrNoChain = errors.New("attestation statement has no certificate chain")
+
+// oidSubjectAltName is the subject alternative name extension. TPM attestation certificates mark it critical with
+// only a directory name, which crypto/x509 does not handle.
+var oidSubjectAltName = asn1.ObjectIdentifier{2, 5, 29, 17}
+
+// Anchor is a trusted root certificate.
+type Anchor struct {
+	// Name is the file the root was loaded from and its subject common name, such as "yubico.pem: Yubico U2F Root CA".
+	Name        string
+	Certificate *x509.Certificate
+}
+
+// Fingerprint returns the SHA-256 fingerprint of the root in hex.
+func (a Anchor) Fingerprint() string {
+	sum := sha256.Sum256(a.Certificate.Raw)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// Store is a set of trust anchors.
+type Store struct {
+	anchors []Anchor
+	pool    *x509.CertPool
+}
+
+// LoadDir loads the PEM encoded roots of the *.pem and *.crt files in dir.
+func LoadDir(dir string) (*Store, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s. error: %w", dir, err)
+	}
+
+	st := &Store{
+		pool: x509.NewCertPool(),
+	}
+
+	for _, file := range files {
+		if file.IsDir() || !slices.Contains([]string{".pem", ".crt"}, strings.ToLower(filepath.Ext(file.Name()))) {
+			continue
+		}
+
+		buf, err := os.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s. error: %w", file.Name(), err)
+		}
+
+		for {
+			var block *pem.Block
+
+			block, buf = pem.Decode(buf)
+			if block == nil {
+				break
+			}
+
+			if block.Type != "CERTIFICATE" {
+				continue
+			}
+
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse certificate in %s. error: %w", file.Name(), err)
+			}
+
+			st.anchors = append(st.anchors, Anchor{
+				Name:        fmt.Sprintf("%s: %s", file.Name(), cert.Subject.CommonName),
+				Certificate: cert,
+			})
+
+			st.pool.AddCert(cert)
+		}
+	}
+
+	if len(st.anchors) == 0 {
+		return nil, fmt.Errorf("no certificate found in %s", dir)
+	}
+
+	return st, nil
+}
+
+// Verify verifies the x5c of an attestation statement of the format and returns the anchor the chain ends in.
+// It returns ErrNoChain when the statement has no x5c.
+func (st *Store) Verify(format string, statement map[string]interface{}, now time.Time) (*Anchor, error) {
+	x5c, ok := statement["x5c"].([]interface{})
+	if !ok || len(x5c) == 0 {
+		return nil, ErrNoChain
+	}
+
+	chain := make([]*x509.Certificate, 0, len(x5c))
+
+	for _, value := range x5c {
+		der, ok := value.([]byte)
+		if !ok {
+			return nil, errors.New("x5c is not a list of certificates")
+		}
+
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse x5c. error: %w", err)
+		}
+
+		chain = append(chain, cert)
+	}
+
+	leaf := chain[0]
+
+	if format == "tpm" {
+		leaf.UnhandledCriticalExtensions = slices.DeleteFunc(leaf.UnhandledCriticalExtensions, func(oid asn1.ObjectIdentifier) bool {
+			return oid.Equal(oidSubjectAltName)
+		})
+	}
+
+	intermediates := x509.NewCertPool()
+
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	chains, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         st.pool,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify certificate chain. error: %w", err)
+	}
+
+	root := chains[0][len(chains[0])-1]
+
+	for i := range st.anchors {
+		if st.anchors[i].Certificate.Equal(root) {
+			return &st.anchors[i], nil
+		}
+	}
+
+	return nil, errors.New("certificate chain does not end in a trust anchor")
+}
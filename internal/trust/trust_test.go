@@ -0,0 +1,181 @@
+package trust
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/certtest"
+)
+
+func issue(t *testing.T, parent *certtest.Cert, tmpl *x509.Certificate) *certtest.Cert {
+	t.Helper()
+
+	var (
+		cert *certtest.Cert
+		err  error
+	)
+
+	if parent == nil {
+		cert, err = certtest.New(tmpl)
+	} else {
+		cert, err = parent.Issue(tmpl)
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return cert
+}
+
+// tpmSubjectAltName returns the subject alternative name extension of TPM attestation certificates: critical and
+// only with a directory name naming the TPM.
+func tpmSubjectAltName(t *testing.T) pkix.Extension {
+	t.Helper()
+
+	name, err := asn1.Marshal(pkix.Name{CommonName: "id:FFFFF1D0"}.ToRDNSequence())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	value, err := asn1.Marshal([]asn1.RawValue{{Class: asn1.ClassContextSpecific, Tag: 4, IsCompound: true, Bytes: name}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pkix.Extension{Id: oidSubjectAltName, Critical: true, Value: value}
+}
+
+func x5c(certs ...*certtest.Cert) map[string]interface{} {
+	chain := make([]interface{}, 0, len(certs))
+
+	for _, cert := range certs {
+		chain = append(chain, cert.Certificate.Raw)
+	}
+
+	return map[string]interface{}{"x5c": chain}
+}
+
+func TestVerify(t *testing.T) {
+	root := issue(t, nil, &x509.Certificate{Subject: pkix.Name{CommonName: "root"}, IsCA: true})
+
+	intermediate := issue(t, root, &x509.Certificate{Subject: pkix.Name{CommonName: "intermediate"}, IsCA: true})
+
+	leaf := issue(t, intermediate, &x509.Certificate{Subject: pkix.Name{CommonName: "leaf"}})
+
+	tpm := issue(t, intermediate, &x509.Certificate{ExtraExtensions: []pkix.Extension{tpmSubjectAltName(t)}})
+
+	expired := issue(t, intermediate, &x509.Certificate{
+		Subject:   pkix.Name{CommonName: "expired"},
+		NotBefore: time.Now().Add(-48 * time.Hour),
+		NotAfter:  time.Now().Add(-24 * time.Hour),
+	})
+
+	unknown := issue(t, nil, &x509.Certificate{Subject: pkix.Name{CommonName: "unknown"}, IsCA: true})
+
+	unknownLeaf := issue(t, unknown, &x509.Certificate{Subject: pkix.Name{CommonName: "unknown leaf"}})
+
+	dir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(dir, "root.pem"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: root.Certificate.Raw}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	st, err := LoadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		format     string
+		statement  map[string]interface{}
+		wantAnchor string
+		wantErr    error
+	}{
+		{
+			name:       "chain to a trusted root",
+			format:     "packed",
+			statement:  x5c(leaf, intermediate),
+			wantAnchor: "root.pem: root",
+		},
+		{
+			name:      "chain without intermediate",
+			format:    "packed",
+			statement: x5c(leaf),
+		},
+		{
+			name:      "chain to an unknown root",
+			format:    "packed",
+			statement: x5c(unknownLeaf, unknown),
+		},
+		{
+			name:      "expired chain",
+			format:    "packed",
+			statement: x5c(expired, intermediate),
+		},
+		{
+			name:      "no x5c",
+			format:    "none",
+			statement: map[string]interface{}{},
+			wantErr:   ErrNoChain,
+		},
+		{
+			name:      "empty x5c",
+			format:    "packed",
+			statement: map[string]interface{}{"x5c": []interface{}{}},
+			wantErr:   ErrNoChain,
+		},
+		{
+			name:      "x5c not of certificates",
+			format:    "packed",
+			statement: map[string]interface{}{"x5c": []interface{}{"leaf"}},
+		},
+		{
+			name:       "tpm subject alternative name",
+			format:     "tpm",
+			statement:  x5c(tpm, intermediate),
+			wantAnchor: "root.pem: root",
+		},
+		{
+			name:      "critical subject alternative name of another format",
+			format:    "packed",
+			statement: x5c(tpm, intermediate),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			anchor, err := st.Verify(tt.format, tt.statement, time.Now())
+
+			if tt.wantAnchor == "" {
+				if err == nil {
+					t.Fatalf("anchor = %s, want an error", anchor.Name)
+				}
+
+				if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+					t.Errorf("error = %v, want %v", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if anchor.Name != tt.wantAnchor {
+				t.Errorf("anchor = %s, want %s", anchor.Name, tt.wantAnchor)
+			}
+		})
+	}
+}
@@ -3,52 +3,128 @@ package main
 import (
 	"bytes"
 	"context"
-	"crypto/aes"
-	"crypto/cipher"
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
+	"os"
+	"time"
 
 	"github.com/go-webauthn/webauthn/protocol"
 	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/rs/cors"
-	"github.com/vmihailenco/msgpack/v5"
 
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/aaguid"
 	"github.com/otakakot/sample-go-webauthn-passkey/internal/api"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/audit"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/auth"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/config"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/enterprise"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/extension"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/keyring"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/mds"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/policy"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/replay"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/sessionstore"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/store"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/trust"
 )
 
 func main() {
-	wa, err := webauthn.New(&webauthn.Config{
-		RPID:                  "localhost",
-		RPDisplayName:         "passkey",
-		RPOrigins:             []string{"http://localhost:5500"},
-		AttestationPreference: protocol.PreferDirectAttestation,
-	})
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		slog.Error(err.Error())
+		os.Exit(2)
+	}
+
+	wa, err := webauthn.New(cfg.WebAuthnConfig())
+	if err != nil {
+		panic(err)
+	}
+
+	kr, err := loadKeyring(cfg.Session)
+	if err != nil {
+		panic(err)
+	}
+
+	sessions, err := loadSessionStore(cfg.Session)
+	if err != nil {
+		panic(err)
+	}
+
+	st, err := store.NewFile(cfg.StorePath)
+	if err != nil {
+		panic(err)
+	}
+
+	trail, err := audit.Open(cfg.AuditPath)
 	if err != nil {
 		panic(err)
 	}
 
-	key := []byte("passw0rdpassw0rdpassw0rdpassw0rd")
+	aaguids, err := aaguid.New()
+	if err != nil {
+		panic(err)
+	}
+
+	if cfg.Metadata.AAGUIDPath != "" {
+		if err := aaguids.LoadFile(cfg.Metadata.AAGUIDPath); err != nil {
+			panic(err)
+		}
+	}
+
+	metadata, err := loadMetadata(cfg.Metadata, aaguids)
+	if err != nil {
+		panic(err)
+	}
+
+	attestation := cfg.AttestationPolicy()
+
+	if path := cfg.Policy.Attestation.EnterpriseInventoryPath; path != "" {
+		if attestation.EnterpriseInventory, err = enterprise.LoadInventory(path); err != nil {
+			panic(err)
+		}
+	}
+
+	var trustStore *trust.Store
+
+	if path := cfg.Policy.Attestation.TrustStorePath; path != "" {
+		if trustStore, err = trust.LoadDir(path); err != nil {
+			panic(err)
+		}
+	}
 
-	block, err := aes.NewCipher(key)
+	mgr, err := auth.NewManager(time.Duration(cfg.Login.IdleTimeout), time.Duration(cfg.Login.AbsoluteTimeout))
 	if err != nil {
 		panic(err)
 	}
 
 	hdl, err := api.NewServer(&Handler{
-		webAuthn: wa,
-		block:    block,
-	})
+		webAuthn:     wa,
+		keyring:      kr,
+		sessions:     sessions,
+		challenges:   replay.NewRegistry(),
+		store:        st,
+		auth:         mgr,
+		audit:        trail,
+		aaguids:      aaguids,
+		metadata:     metadata,
+		attestation:  attestation,
+		trustStore:   trustStore,
+		admins:       cfg.AdminUserIDs(),
+		extensions:   cfg.ExtensionConfig(),
+		cloneWarning: cfg.Policy.CloneWarning,
+	}, api.WithMiddleware(mgr.Middleware, acceptMiddleware))
 	if err != nil {
 		panic(err)
 	}
 
 	options := cors.Options{
-		AllowedOrigins: []string{"http://*"},
+		AllowedOrigins: cfg.CORSAllowedOrigins(),
 		AllowedMethods: []string{
 			http.MethodHead,
 			http.MethodGet,
@@ -61,123 +137,327 @@ func main() {
 	}
 
 	srv := &http.Server{
-		Addr:    ":8080",
+		Addr:    cfg.Addr,
 		Handler: cors.New(options).Handler(hdl),
 	}
 
-	slog.Info("Listening on http://localhost:8080")
+	slog.Info("Listening on " + cfg.Addr)
 
 	if err := srv.ListenAndServe(); err != nil {
 		panic(err)
 	}
 }
 
-var _ webauthn.User = (*User)(nil)
+// loadKeyring loads the keys sealing the session cookie. When no key is configured a random key is generated, so
+// sessions do not survive a restart.
+func loadKeyring(cfg config.Session) (*keyring.Keyring, error) {
+	if cfg.Keys == "" {
+		slog.Warn("SESSION_KEYS is not set. using a random key")
 
-type User struct {
-	ID string
-}
+		key, err := keyring.Generate("ephemeral")
+		if err != nil {
+			return nil, err
+		}
 
-// WebAuthnCredentials implements webauthn.User.
-func (us *User) WebAuthnCredentials() []webauthn.Credential {
-	return nil
-}
+		return keyring.New(key)
+	}
 
-// WebAuthnDisplayName implements webauthn.User.
-func (us *User) WebAuthnDisplayName() string {
-	return ""
-}
+	keys, err := keyring.Parse(cfg.Keys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse session keys. error: %w", err)
+	}
 
-// WebAuthnID implements webauthn.User.
-func (us *User) WebAuthnID() []byte {
-	return []byte(us.ID)
+	return keyring.New(keys...)
 }
 
-// WebAuthnIcon implements webauthn.User.
-func (us *User) WebAuthnIcon() string {
-	return ""
+// loadSessionStore returns the configured store: "cookie" keeps the ceremony session sealed in the cookie, "memory"
+// and "redis" keep it on the server.
+func loadSessionStore(cfg config.Session) (sessionstore.Store, error) {
+	switch cfg.Store {
+	case "cookie":
+		return nil, nil
+	case "memory":
+		return sessionstore.NewMemory(), nil
+	case "redis":
+		return sessionstore.NewRedis(cfg.RedisAddr), nil
+	default:
+		return nil, fmt.Errorf("unknown session store %q. must be one of cookie, memory or redis", cfg.Store)
+	}
 }
 
-// WebAuthnName implements webauthn.User.
-func (us *User) WebAuthnName() string {
-	return ""
+// loadMetadata loads the FIDO Metadata Service BLOB and keeps refreshing it in the background. It returns nil when
+// no BLOB is configured. The authenticators of the BLOB are added to aaguids.
+func loadMetadata(cfg config.Metadata, aaguids *aaguid.Registry) (*mds.Service, error) {
+	if cfg.MDSSource == "" {
+		return nil, nil
+	}
+
+	roots, err := mds.LoadRoots(cfg.MDSRootPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load metadata roots. error: %w", err)
+	}
+
+	svc := mds.New(cfg.MDSSource, roots, aaguids.AddMetadata)
+
+	if err := svc.Refresh(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed to load metadata BLOB. error: %w", err)
+	}
+
+	go svc.Run(context.Background(), time.Duration(cfg.MDSRefreshInterval))
+
+	return svc, nil
 }
 
 var _ api.Handler = (*Handler)(nil)
 
 type Handler struct {
-	webAuthn *webauthn.WebAuthn
-	block    cipher.Block
+	webAuthn   *webauthn.WebAuthn
+	keyring    *keyring.Keyring
+	sessions   sessionstore.Store
+	challenges *replay.Registry
+	store      store.CredentialStore
+	auth       *auth.Manager
+	audit      *audit.Trail
+	aaguids    *aaguid.Registry
+	metadata   *mds.Service
+
+	// attestation is the policy deciding which authenticators may be registered.
+	attestation policy.Attestation
+
+	// trustStore is the roots attestation certificate chains are verified against, nil if none is configured.
+	trustStore *trust.Store
+
+	// extensions is the WebAuthn extensions requested by the ceremonies.
+	extensions extension.Config
+
+	// admins are the user handles of the users allowed to call the admin operations.
+	admins [][]byte
+
+	// cloneWarning is the reaction to a signature counter which did not increase.
+	cloneWarning string
 }
 
-// InitializeAttestation implements api.Handler.
-func (hdl *Handler) InitializeAttestation(ctx context.Context) (api.InitializeAttestationRes, error) {
-	options, session, err := hdl.webAuthn.BeginRegistration(&User{
-		ID: "passkey",
-	})
-	if err != nil {
-		return &api.ErrorResponse{
-			Message: fmt.Sprintf("failed to begin registration. error: %s", err),
-		}, nil
-	}
+// Session is the state carried from the initialize operation to the finalize operation of a ceremony.
+type Session struct {
+	webauthn.SessionData
+
+	// NOTE: 登録のセッションをログインに、ログインのセッションを登録に使えないようにする
+	Ceremony string `json:"ceremony"`
+
+	// NOTE: 登録が完了するまでユーザーは保存しないのでセッションで連れ回す
+	UserName        string `json:"userName,omitempty"`
+	UserDisplayName string `json:"userDisplayName,omitempty"`
+
+	// NOTE: ログイン中のユーザーに発行した登録のセッションだけが既存のユーザーにパスキーを追加できる
+	LoggedIn bool `json:"loggedIn,omitempty"`
+}
 
-	var buf bytes.Buffer
+// Ceremonies a session is issued for.
+const (
+	ceremonyRegistration = "registration"
+	ceremonyLogin        = "login"
+)
+
+// NOTE: サーバー側のセッションストアが設定されていなければ暗号化して cookie でそのまま連れ回す
+// NOTE: 改ざんされないように AEAD で暗号化する
 
-	enc := msgpack.NewEncoder(&buf)
+// sessionTTL is how long a ceremony session stays valid when neither the session nor the options have a timeout.
+// It matches the default timeout of the webauthn package.
+const sessionTTL = 5 * time.Minute
+
+var (
+	errSessionExpired  = errors.New("session has expired")
+	errSessionMismatch = errors.New("session was not issued for this request")
+)
 
-	enc.SetCustomStructTag("json")
+// sessionAdditionalData binds the sealed value to the ceremony session cookie so that a value sealed for
+// another purpose with the same keyring is rejected.
+var sessionAdditionalData = []byte("session")
+
+// saveSession saves the session and returns the value of the cookie referring to it. Without a session store
+// the whole session is sealed into the cookie, otherwise the cookie only carries a random id.
+//
+// timeout is the timeout told to the browser in the options, which is the expiry of a session the webauthn package
+// did not set one for.
+func (hdl *Handler) saveSession(ctx context.Context, session *Session, timeout time.Duration) (string, error) {
+	if timeout <= 0 {
+		timeout = sessionTTL
+	}
 
-	if err := enc.Encode(options.Response); err != nil {
-		return &api.ErrorResponse{
-			Message: fmt.Sprintf("failed to encode credential creation options. error: %s", err),
-		}, err
+	// NOTE: 使用済みチャレンジをいつまで覚えておけばいいか決まるように必ず有効期限を設定する
+	// NOTE: ブラウザに伝えたタイムアウトより先にサーバー側で期限切れにしない
+	if session.Expires.IsZero() {
+		session.Expires = time.Now().Add(timeout)
 	}
 
 	jsonSession, err := json.Marshal(session)
 	if err != nil {
-		return &api.ErrorResponse{
-			Message: fmt.Sprintf("failed to marshal session. error: %s", err),
-		}, err
+		return "", fmt.Errorf("failed to marshal session. error: %w", err)
 	}
 
-	// NOTE: セッションを Redis なりに保存してキーを cookie に設定すべきかも
-	// NOTE: キャッシュを実装するのがめんどくさかったので暗号化してそのまま連れ回す
-	// NOTE: セッションが漏れても問題ないものであるならば不要な暗号化
-	// TODO: セッションって流出して問題ないのか確認する
+	if hdl.sessions == nil {
+		value, err := hdl.keyring.Seal(jsonSession, sessionAdditionalData)
+		if err != nil {
+			return "", fmt.Errorf("failed to seal session. error: %w", err)
+		}
+
+		return value, nil
+	}
 
-	// NOTE: セッションを暗号化して cookie に保存
+	buf := make([]byte, 32)
 
-	cipherSession := make([]byte, aes.BlockSize+len(jsonSession))
+	if _, err := io.ReadFull(rand.Reader, buf); err != nil {
+		return "", fmt.Errorf("failed to read random. error: %w", err)
+	}
 
-	iv := cipherSession[:aes.BlockSize]
+	id := base64.RawURLEncoding.EncodeToString(buf)
 
-	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
-		return &api.ErrorResponse{
-			Message: fmt.Sprintf("failed to read random. error: %s", err),
+	if err := hdl.sessions.Save(ctx, id, jsonSession, time.Until(session.Expires)); err != nil {
+		return "", fmt.Errorf("failed to save session. error: %w", err)
+	}
+
+	return id, nil
+}
+
+// takeSession returns the session the cookie value refers to and consumes its challenge, so that neither the
+// session nor a captured cookie carrying it can be used again. A session kept in the session store is deleted.
+// A session issued for another ceremony is rejected.
+func (hdl *Handler) takeSession(ctx context.Context, value string, ceremony string) (*Session, error) {
+	var (
+		jsonSession []byte
+		err         error
+	)
+
+	if hdl.sessions == nil {
+		jsonSession, err = hdl.keyring.Open(value, sessionAdditionalData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open session. error: %w", err)
+		}
+	} else {
+		jsonSession, err = hdl.sessions.Take(ctx, value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to take session. error: %w", err)
+		}
+	}
+
+	var session Session
+
+	if err := json.Unmarshal(jsonSession, &session); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal session. error: %w", err)
+	}
+
+	if session.Ceremony != ceremony {
+		return nil, errSessionMismatch
+	}
+
+	if !session.Expires.After(time.Now()) {
+		return nil, errSessionExpired
+	}
+
+	if err := hdl.challenges.Consume(session.Challenge, session.Expires); err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+// errorCode returns the machine readable code reported for err.
+func errorCode(err error) api.OptString {
+	switch {
+	case errors.Is(err, replay.ErrReplayed):
+		return api.NewOptString("challenge_replayed")
+	case errors.Is(err, errSessionExpired):
+		return api.NewOptString("session_expired")
+	case errors.Is(err, errSessionMismatch):
+		return api.NewOptString("session_mismatch")
+	case errors.Is(err, sessionstore.ErrNotFound):
+		return api.NewOptString("session_not_found")
+	case errors.Is(err, errClonedAuthenticator):
+		return api.NewOptString("cloned_authenticator")
+	case errors.Is(err, errBackupEligibilityChanged):
+		return api.NewOptString("backup_eligibility_changed")
+	default:
+		return api.OptString{}
+	}
+}
+
+// deleteSession deletes the session the cookie value refers to from the session store.
+func (hdl *Handler) deleteSession(ctx context.Context, value string) error {
+	if hdl.sessions == nil {
+		return nil
+	}
+
+	return hdl.sessions.Delete(ctx, value)
+}
+
+// InitializeAttestation implements api.Handler.
+func (hdl *Handler) InitializeAttestation(ctx context.Context, params api.InitializeAttestationParams) (api.InitializeAttestationRes, error) {
+	f, err := negotiate(ctx)
+	if err != nil {
+		return &api.InitializeAttestationNotAcceptable{
+			Message: err.Error(),
+		}, nil
+	}
+
+	user, err := hdl.registeringUser(ctx, params.Name, params.DisplayName.Or(params.Name))
+	if errors.Is(err, store.ErrAlreadyExists) {
+		return &api.InitializeAttestationConflict{
+			Message: fmt.Sprintf("user %s is already registered", params.Name),
+		}, nil
+	}
+	if err != nil {
+		return &api.InitializeAttestationInternalServerError{
+			Message: fmt.Sprintf("failed to create user. error: %s", err),
+		}, nil
+	}
+
+	// NOTE: discoverable credential の要否などは設定の authenticatorSelection に従う
+	// NOTE: 同じ認証器を何度も登録しないように登録済みのクレデンシャルを除外する
+	options, session, err := hdl.webAuthn.BeginRegistration(
+		user,
+		webauthn.WithExclusions(user.exclusions()),
+		webauthn.WithExtensions(hdl.extensions.Registration()),
+	)
+	if err != nil {
+		return &api.InitializeAttestationInternalServerError{
+			Message: fmt.Sprintf("failed to begin registration. error: %s", err),
 		}, nil
 	}
 
-	encryptStream := cipher.NewCTR(hdl.block, iv)
+	ss, loggedIn := auth.FromContext(ctx)
 
-	encryptStream.XORKeyStream(cipherSession[aes.BlockSize:], jsonSession)
+	value, err := hdl.saveSession(ctx, &Session{
+		SessionData:     *session,
+		Ceremony:        ceremonyRegistration,
+		UserName:        user.Name,
+		UserDisplayName: user.DisplayName,
+		LoggedIn:        loggedIn && bytes.Equal(ss.UserID, user.ID),
+	}, time.Duration(options.Response.Timeout)*time.Millisecond)
+	if err != nil {
+		return &api.InitializeAttestationInternalServerError{
+			Message: fmt.Sprintf("failed to save session. error: %s", err),
+		}, nil
+	}
 
 	cookie := http.Cookie{
 		Name:     "session",
-		Value:    base64.StdEncoding.EncodeToString(cipherSession),
+		Value:    value,
 		Path:     "/",
 		Domain:   "",
 		Secure:   true,
 		HttpOnly: true,
-		SameSite: http.SameSiteNoneMode,
+		SameSite: http.SameSiteStrictMode,
 		MaxAge:   0,
 	}
 
-	return &api.InitializeAttestationOKHeaders{
-		SetCookie: api.NewOptString(cookie.String()),
-		Response: api.InitializeAttestationOK{
-			Data: &buf,
-		},
-	}, nil
+	res, err := creationOptionsResponse(f, options.Response, cookie.String())
+	if err != nil {
+		return &api.InitializeAttestationInternalServerError{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return res, nil
 }
 
 // FinalizeAttestation implements api.Handler.
@@ -189,12 +469,35 @@ func (hdl *Handler) FinalizeAttestation(ctx context.Context, req api.FinalizeAtt
 		MaxAge: -1,
 	}
 
-	// NOTE: MessagePack 使いたかったけど挫折 ...
-	// NOTE: JavaScript 側から MessagePack 形式で送信することができなかった ...
+	// NOTE: セッションは検証の成否に関わらず一度しか使えないように最初に取り出す
+	session, err := hdl.takeSession(ctx, params.Session, ceremonyRegistration)
+	if err == nil && len(session.UserID) == 0 {
+		err = errSessionMismatch
+	}
+	if err != nil {
+		return &api.FinalizeAttestationBadRequest{
+			SetCookie: api.NewOptString(cookie.String()),
+			Response: api.ErrorResponse{
+				Code:    errorCode(err),
+				Message: fmt.Sprintf("failed to load session. error: %s", err),
+			},
+		}, nil
+	}
+
+	// NOTE: 既存のユーザーへの追加はセッションを発行したときと同じユーザーでログインしている間だけ許す
+	if ss, ok := auth.FromContext(ctx); session.LoggedIn && (!ok || !bytes.Equal(ss.UserID, session.UserID)) {
+		return &api.FinalizeAttestationBadRequest{
+			SetCookie: api.NewOptString(cookie.String()),
+			Response: api.ErrorResponse{
+				Code:    errorCode(errSessionMismatch),
+				Message: "failed to load session. error: session was issued to another login",
+			},
+		}, nil
+	}
 
-	data, err := protocol.ParseCredentialCreationResponseBody(req.Data)
+	data, err := parseCredentialCreation(req)
 	if err != nil {
-		return &api.ErrorResponseHeaders{
+		return &api.FinalizeAttestationBadRequest{
 			SetCookie: api.NewOptString(cookie.String()),
 			Response: api.ErrorResponse{
 				Message: fmt.Sprintf("failed to parse credential creation. error: %s", err),
@@ -202,118 +505,468 @@ func (hdl *Handler) FinalizeAttestation(ctx context.Context, req api.FinalizeAtt
 		}, nil
 	}
 
-	dec, err := base64.StdEncoding.DecodeString(params.Session)
+	user := &User{
+		ID:          session.UserID,
+		Name:        session.UserName,
+		DisplayName: session.UserDisplayName,
+	}
+
+	cred, err := hdl.webAuthn.CreateCredential(user, session.SessionData, data)
 	if err != nil {
-		return &api.ErrorResponseHeaders{
+		return &api.FinalizeAttestationBadRequest{
 			SetCookie: api.NewOptString(cookie.String()),
 			Response: api.ErrorResponse{
-				Message: fmt.Sprintf("failed to base64 decode. error: %s", err),
+				Message: fmt.Sprintf("failed to create credential. error: %s", err),
 			},
 		}, nil
 	}
 
-	// NOTE: cookie に保存されているセッションを復号化(復号)
-
-	decryptedSession := make([]byte, len(dec[aes.BlockSize:]))
+	outputs, err := hdl.extensions.VerifyRegistration(data.ClientExtensionResults, data.Response.AttestationObject.AuthData.ExtData)
+	if err != nil {
+		return &api.FinalizeAttestationBadRequest{
+			SetCookie: api.NewOptString(cookie.String()),
+			Response: api.ErrorResponse{
+				Message: fmt.Sprintf("failed to verify extension outputs. error: %s", err),
+			},
+		}, nil
+	}
 
-	decryptStream := cipher.NewCTR(hdl.block, dec[:aes.BlockSize])
+	// NOTE: prf に対応しないと分かっている認証器以外はソルトを用意しておく
+	var salt []byte
+
+	if hdl.extensions.PRF && (outputs.PRF == nil || *outputs.PRF) {
+		salt, err = extension.NewPRFSalt()
+		if err != nil {
+			return &api.FinalizeAttestationInternalServerError{
+				SetCookie: api.NewOptString(cookie.String()),
+				Response: api.ErrorResponse{
+					Message: fmt.Sprintf("failed to generate prf salt. error: %s", err),
+				},
+			}, nil
+		}
+	}
 
-	decryptStream.XORKeyStream(decryptedSession, dec[aes.BlockSize:])
+	registered := store.Credential{
+		UserID:            user.ID,
+		Credential:        *cred,
+		AttestationObject: data.Raw.AttestationResponse.AttestationObject,
+		ClientDataJSON:    data.Raw.AttestationResponse.ClientDataJSON,
+		Extensions:        outputs,
+		PRFSalt:           salt,
+	}
 
-	var session webauthn.SessionData
+	if err := hdl.evaluateAttestation(ctx, &registered, data.Response.AttestationObject); err != nil {
+		var violation *policy.Violation
+		if !errors.As(err, &violation) {
+			return &api.FinalizeAttestationInternalServerError{
+				SetCookie: api.NewOptString(cookie.String()),
+				Response: api.ErrorResponse{
+					Message: fmt.Sprintf("failed to evaluate attestation policy. error: %s", err),
+				},
+			}, nil
+		}
+
+		if err := hdl.audit.Record(ctx, audit.Event{
+			Type:         audit.TypeRegistrationRejected,
+			UserID:       user.ID,
+			CredentialID: cred.ID,
+			Detail:       violation.Error(),
+		}); err != nil {
+			slog.ErrorContext(ctx, fmt.Sprintf("failed to record audit event. error: %s", err))
+		}
+
+		return &api.FinalizeAttestationForbidden{
+			SetCookie: api.NewOptString(cookie.String()),
+			Response: api.ErrorResponse{
+				Code:    api.NewOptString("attestation_policy_violation"),
+				Message: violation.Error(),
+				Rule:    api.NewOptString(violation.Rule),
+			},
+		}, nil
+	}
 
-	if err := json.Unmarshal(decryptedSession, &session); err != nil {
-		return &api.ErrorResponseHeaders{
+	// NOTE: ユーザーを保存する前に確認して、登録できないクレデンシャルのためにユーザーだけが残らないようにする
+	if _, err := hdl.store.FindCredential(ctx, cred.ID); err == nil {
+		return credentialConflict(cookie), nil
+	} else if !errors.Is(err, store.ErrNotFound) {
+		return &api.FinalizeAttestationInternalServerError{
 			SetCookie: api.NewOptString(cookie.String()),
 			Response: api.ErrorResponse{
-				Message: fmt.Sprintf("failed to unmarshal session. error: %s", err),
+				Message: fmt.Sprintf("failed to find credential. error: %s", err),
 			},
 		}, nil
 	}
 
-	cred, err := hdl.webAuthn.CreateCredential(&User{ID: "passkey"}, session, data)
+	// NOTE: ログイン中のユーザーがパスキーを追加する場合はユーザーが保存済み
+	// NOTE: それ以外は必ず新しいユーザーとして保存して、既存のユーザーにパスキーを追加させない
+	if !session.LoggedIn {
+		err = hdl.store.CreateUser(ctx, store.User{
+			ID:          user.ID,
+			Name:        user.Name,
+			DisplayName: user.DisplayName,
+		})
+		// NOTE: 登録の途中で同じ名前のユーザーが先に登録された
+		if errors.Is(err, store.ErrAlreadyExists) {
+			return &api.FinalizeAttestationConflict{
+				SetCookie: api.NewOptString(cookie.String()),
+				Response: api.ErrorResponse{
+					Code:    api.NewOptString("user_already_registered"),
+					Message: fmt.Sprintf("user %s is already registered", user.Name),
+				},
+			}, nil
+		}
+		if err != nil {
+			return &api.FinalizeAttestationInternalServerError{
+				SetCookie: api.NewOptString(cookie.String()),
+				Response: api.ErrorResponse{
+					Message: fmt.Sprintf("failed to create user. error: %s", err),
+				},
+			}, nil
+		}
+	}
+
+	err = hdl.store.CreateCredential(ctx, registered)
+	if errors.Is(err, store.ErrAlreadyExists) {
+		return credentialConflict(cookie), nil
+	}
 	if err != nil {
-		return &api.ErrorResponseHeaders{
+		return &api.FinalizeAttestationInternalServerError{
 			SetCookie: api.NewOptString(cookie.String()),
 			Response: api.ErrorResponse{
-				Message: fmt.Sprintf("failed to create credential. error: %s", err),
+				Message: fmt.Sprintf("failed to save credential. error: %s", err),
 			},
 		}, nil
 	}
 
-	// FIXME: ここでクレデンシャルを保存する
-
 	slog.Info(fmt.Sprintf("credential id: %+v", cred))
 
+	if err := hdl.audit.Record(ctx, audit.Event{
+		Type:         audit.TypeCredentialRegistered,
+		UserID:       user.ID,
+		CredentialID: cred.ID,
+		Detail:       hdl.describeAuthenticator(&registered),
+	}); err != nil {
+		return &api.FinalizeAttestationInternalServerError{
+			SetCookie: api.NewOptString(cookie.String()),
+			Response: api.ErrorResponse{
+				Message: fmt.Sprintf("failed to record audit event. error: %s", err),
+			},
+		}, nil
+	}
+
 	return &api.FinalizeAttestationOK{
 		SetCookie: api.NewOptString(cookie.String()),
 	}, nil
 }
 
+// credentialConflict is the response to a credential which has already been registered by any user.
+func credentialConflict(cookie http.Cookie) *api.FinalizeAttestationConflict {
+	return &api.FinalizeAttestationConflict{
+		SetCookie: api.NewOptString(cookie.String()),
+		Response: api.ErrorResponse{
+			Code:    api.NewOptString("credential_already_registered"),
+			Message: "credential is already registered",
+		},
+	}
+}
+
 // InitializeAssertion implements api.Handler.
-func (hdl *Handler) InitializeAssertion(ctx context.Context) (api.InitializeAssertionRes, error) {
-	panic("unimplemented")
+func (hdl *Handler) InitializeAssertion(ctx context.Context, params api.InitializeAssertionParams) (api.InitializeAssertionRes, error) {
+	f, err := negotiate(ctx)
+	if err != nil {
+		return &api.InitializeAssertionNotAcceptable{
+			Message: err.Error(),
+		}, nil
+	}
+
+	var (
+		options *protocol.CredentialAssertion
+		session *webauthn.SessionData
+		maxAge  int
+	)
+
+	name, hasName := params.Name.Get()
+
+	switch {
+	case isConditional(params.Mediation):
+		// NOTE: conditional mediation ではユーザー名が決まっていないので必ず discoverable credential でログインする
+		if hasName {
+			return &api.InitializeAssertionBadRequest{
+				Message: "name must be omitted for conditional mediation",
+			}, nil
+		}
+
+		options, session, err = hdl.webAuthn.BeginDiscoverableLogin(withTimeout(conditionalTimeout), webauthn.WithAssertionExtensions(hdl.extensions.Assertion(nil)))
+		if err != nil {
+			return &api.InitializeAssertionInternalServerError{
+				Message: fmt.Sprintf("failed to begin discoverable login. error: %s", err),
+			}, nil
+		}
+
+		// NOTE: ページを開いたまま放置されることがあるので長めの有効期限をサーバー側で強制する
+		session.Expires = time.Now().Add(conditionalTimeout)
+
+		maxAge = int(conditionalTimeout.Seconds())
+	case hasName:
+		user, err := hdl.findUserByName(ctx, name)
+		if errors.Is(err, store.ErrNotFound) {
+			return &api.InitializeAssertionNotFound{
+				Code:    api.NewOptString("user_not_found"),
+				Message: fmt.Sprintf("user %s is not found", name),
+			}, nil
+		}
+		if err != nil {
+			return &api.InitializeAssertionInternalServerError{
+				Message: fmt.Sprintf("failed to find user. error: %s", err),
+			}, nil
+		}
+
+		var salts map[string][]byte
+
+		if hdl.extensions.PRF {
+			salts, err = hdl.prfSalts(ctx, user.ID)
+			if err != nil {
+				return &api.InitializeAssertionInternalServerError{
+					Message: fmt.Sprintf("failed to prepare prf salts. error: %s", err),
+				}, nil
+			}
+		}
+
+		options, session, err = hdl.webAuthn.BeginLogin(user, webauthn.WithAssertionExtensions(hdl.extensions.Assertion(salts)))
+		if err != nil {
+			return &api.InitializeAssertionInternalServerError{
+				Message: fmt.Sprintf("failed to begin login. error: %s", err),
+			}, nil
+		}
+	default:
+		// NOTE: ユーザー名が指定されなければ discoverable credential でログインする
+		options, session, err = hdl.webAuthn.BeginDiscoverableLogin(webauthn.WithAssertionExtensions(hdl.extensions.Assertion(nil)))
+		if err != nil {
+			return &api.InitializeAssertionInternalServerError{
+				Message: fmt.Sprintf("failed to begin discoverable login. error: %s", err),
+			}, nil
+		}
+	}
+
+	value, err := hdl.saveSession(ctx, &Session{
+		SessionData: *session,
+		Ceremony:    ceremonyLogin,
+	}, time.Duration(options.Response.Timeout)*time.Millisecond)
+	if err != nil {
+		return &api.InitializeAssertionInternalServerError{
+			Message: fmt.Sprintf("failed to save session. error: %s", err),
+		}, nil
+	}
+
+	// NOTE: conditional mediation は別の cookie を使うので、裏で待機しているリクエストが明示的なログインのセッションを上書きしない
+	// NOTE: 再度リクエストされた場合は新しいチャレンジで上書きする
+	cookie := http.Cookie{
+		Name:     assertionCookieName(params.Mediation),
+		Value:    value,
+		Path:     "/",
+		Domain:   "",
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+		MaxAge:   maxAge,
+	}
+
+	res, err := requestOptionsResponse(f, options.Response, cookie.String())
+	if err != nil {
+		return &api.InitializeAssertionInternalServerError{
+			Message: err.Error(),
+		}, nil
+	}
+
+	return res, nil
 }
 
 // FinalizeAssertion implements api.Handler.
-func (hdl *Handler) FinalizeAssertion(ctx context.Context, req api.OptFinalizeAssertionRequest) (api.FinalizeAssertionRes, error) {
-	panic("unimplemented")
-}
+func (hdl *Handler) FinalizeAssertion(ctx context.Context, req api.FinalizeAssertionReq, params api.FinalizeAssertionParams) (api.FinalizeAssertionRes, error) {
+	// NOTE: セッションを無効にするための cookie
+	cookie := http.Cookie{
+		Name:   assertionCookieName(params.Mediation),
+		Value:  "",
+		MaxAge: -1,
+	}
+
+	value, ok := params.Session.Get()
+	if isConditional(params.Mediation) {
+		value, ok = params.ConditionalSession.Get()
+	}
+
+	if !ok {
+		return &api.FinalizeAssertionUnauthorized{
+			SetCookie: api.NewOptString(cookie.String()),
+			Response: api.ErrorResponse{
+				Message: fmt.Sprintf("cookie %s is required", cookie.Name),
+			},
+		}, nil
+	}
 
-// InitializeAttestationJSON implements api.Handler.
-func (hdl *Handler) InitializeAttestationJSON(ctx context.Context) (api.InitializeAttestationJSONRes, error) {
-	options, session, err := hdl.webAuthn.BeginRegistration(&User{
-		ID: "passkey",
-	})
+	// NOTE: セッションは検証の成否に関わらず一度しか使えないように最初に取り出す
+	session, err := hdl.takeSession(ctx, value, ceremonyLogin)
 	if err != nil {
-		return &api.ErrorResponse{
-			Message: fmt.Sprintf("failed to begin registration. error: %s", err),
+		return &api.FinalizeAssertionUnauthorized{
+			SetCookie: api.NewOptString(cookie.String()),
+			Response: api.ErrorResponse{
+				Code:    errorCode(err),
+				Message: fmt.Sprintf("failed to load session. error: %s", err),
+			},
 		}, nil
 	}
 
-	body, err := json.Marshal(options.Response)
+	data, err := parseCredentialAssertion(req)
 	if err != nil {
-		return &api.ErrorResponse{
-			Message: fmt.Sprintf("failed to marshal credential creation options. error: %s", err),
+		return &api.FinalizeAssertionUnauthorized{
+			SetCookie: api.NewOptString(cookie.String()),
+			Response: api.ErrorResponse{
+				Message: fmt.Sprintf("failed to parse credential request. error: %s", err),
+			},
 		}, nil
 	}
 
-	jsonSession, err := json.Marshal(session)
+	user, cred, err := hdl.validateLogin(ctx, session, data)
 	if err != nil {
-		return &api.ErrorResponse{
-			Message: fmt.Sprintf("failed to marshal session. error: %s", err),
-		}, err
+		return &api.FinalizeAssertionUnauthorized{
+			SetCookie: api.NewOptString(cookie.String()),
+			Response: api.ErrorResponse{
+				Message: fmt.Sprintf("failed to validate login. error: %s", err),
+			},
+		}, nil
 	}
 
-	cipherSession := make([]byte, aes.BlockSize+len(jsonSession))
+	prf, err := hdl.extensions.VerifyAssertion(session.Extensions, cred.ID, data.ClientExtensionResults)
+	if err != nil {
+		return &api.FinalizeAssertionUnauthorized{
+			SetCookie: api.NewOptString(cookie.String()),
+			Response: api.ErrorResponse{
+				Message: fmt.Sprintf("failed to verify extension outputs. error: %s", err),
+			},
+		}, nil
+	}
 
-	iv := cipherSession[:aes.BlockSize]
+	err = hdl.recordAssertion(ctx, user.ID, cred.ID, data.Response.AuthenticatorData)
+	if errors.Is(err, errClonedAuthenticator) || errors.Is(err, errBackupEligibilityChanged) {
+		return &api.FinalizeAssertionUnauthorized{
+			SetCookie: api.NewOptString(cookie.String()),
+			Response: api.ErrorResponse{
+				Code:    errorCode(err),
+				Message: fmt.Sprintf("failed to validate login. error: %s", err),
+			},
+		}, nil
+	}
+	if err != nil {
+		return &api.FinalizeAssertionInternalServerError{
+			SetCookie: api.NewOptString(cookie.String()),
+			Response: api.ErrorResponse{
+				Message: err.Error(),
+			},
+		}, nil
+	}
 
-	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
-		return &api.ErrorResponse{
-			Message: fmt.Sprintf("failed to read random. error: %s", err),
+	// NOTE: prf に対応しないブラウザでログインしただけで無効にならないように、得られたときだけ記録する
+	if prf != nil && *prf {
+		if err := hdl.confirmPRF(ctx, cred.ID); err != nil {
+			return &api.FinalizeAssertionInternalServerError{
+				SetCookie: api.NewOptString(cookie.String()),
+				Response: api.ErrorResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+	}
+
+	login, err := hdl.auth.Login(user.ID, cred.ID)
+	if err != nil {
+		return &api.FinalizeAssertionInternalServerError{
+			SetCookie: api.NewOptString(cookie.String()),
+			Response: api.ErrorResponse{
+				Message: fmt.Sprintf("failed to login. error: %s", err),
+			},
 		}, nil
 	}
 
-	encryptStream := cipher.NewCTR(hdl.block, iv)
+	if err := hdl.audit.Record(ctx, audit.Event{
+		Type:         audit.TypeLogin,
+		UserID:       user.ID,
+		CredentialID: cred.ID,
+	}); err != nil {
+		return &api.FinalizeAssertionInternalServerError{
+			SetCookie: api.NewOptString(cookie.String()),
+			Response: api.ErrorResponse{
+				Message: fmt.Sprintf("failed to record audit event. error: %s", err),
+			},
+		}, nil
+	}
 
-	encryptStream.XORKeyStream(cipherSession[aes.BlockSize:], jsonSession)
+	return &api.FinalizeAssertionResponseHeaders{
+		SetCookie: []string{cookie.String(), login.String()},
+		Response: api.FinalizeAssertionResponse{
+			Name:         user.Name,
+			CredentialId: base64.RawURLEncoding.EncodeToString(cred.ID),
+			UserVerified: cred.Flags.UserVerified,
+			SignCount:    int64(data.Response.AuthenticatorData.Counter),
+		},
+	}, nil
+}
 
+// AbortAssertion implements api.Handler.
+func (hdl *Handler) AbortAssertion(ctx context.Context, params api.AbortAssertionParams) (*api.AbortAssertionNoContent, error) {
 	cookie := http.Cookie{
-		Name:     "session",
-		Value:    base64.StdEncoding.EncodeToString(cipherSession),
-		Path:     "/",
-		Domain:   "",
-		Secure:   true,
-		HttpOnly: true,
-		SameSite: http.SameSiteNoneMode,
-		MaxAge:   0,
+		Name:   assertionCookieName(params.Mediation),
+		Value:  "",
+		MaxAge: -1,
+	}
+
+	value, ok := params.Session.Get()
+	if isConditional(params.Mediation) {
+		value, ok = params.ConditionalSession.Get()
 	}
 
-	return &api.InitializeAttestationJSONOKHeaders{
+	if ok {
+		if err := hdl.deleteSession(ctx, value); err != nil {
+			return nil, fmt.Errorf("failed to delete session. error: %w", err)
+		}
+	}
+
+	return &api.AbortAssertionNoContent{
 		SetCookie: api.NewOptString(cookie.String()),
-		Response: api.InitializeAttestationJSONOK{
-			Data: bytes.NewReader(body),
-		},
 	}, nil
 }
+
+// validateLogin validates the assertion against the user the session was initialized for,
+// or against the user identified by the user handle for a discoverable login.
+func (hdl *Handler) validateLogin(ctx context.Context, session *Session, data *protocol.ParsedCredentialAssertionData) (*User, *webauthn.Credential, error) {
+	if len(session.UserID) == 0 {
+		var user *User
+
+		cred, err := hdl.webAuthn.ValidateDiscoverableLogin(func(rawID, userHandle []byte) (webauthn.User, error) {
+			us, err := hdl.findUser(ctx, userHandle)
+			if err != nil {
+				return nil, err
+			}
+
+			user = us
+
+			return us, nil
+		}, session.SessionData, data)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return user, cred, nil
+	}
+
+	user, err := hdl.findUser(ctx, session.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cred, err := hdl.webAuthn.ValidateLogin(user, session.SessionData, data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return user, cred, nil
+}
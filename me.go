@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/api"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/auth"
+)
+
+// GetMe implements api.Handler.
+func (hdl *Handler) GetMe(ctx context.Context) (api.GetMeRes, error) {
+	ss, ok := auth.FromContext(ctx)
+	if !ok {
+		return &api.GetMeUnauthorized{
+			Message: "login is required",
+		}, nil
+	}
+
+	user, err := hdl.store.FindUser(ctx, ss.UserID)
+	if err != nil {
+		return &api.GetMeInternalServerError{
+			Message: fmt.Sprintf("failed to find user. error: %s", err),
+		}, nil
+	}
+
+	cred, err := hdl.store.FindCredential(ctx, ss.CredentialID)
+	if err != nil {
+		return &api.GetMeInternalServerError{
+			Message: fmt.Sprintf("failed to find credential. error: %s", err),
+		}, nil
+	}
+
+	return &api.Me{
+		User: api.User{
+			ID:          base64.RawURLEncoding.EncodeToString(user.ID),
+			Name:        user.Name,
+			DisplayName: user.DisplayName,
+		},
+		Credential: hdl.credentialResponse(cred),
+		Session: api.LoginSession{
+			CreatedAt:  ss.CreatedAt,
+			LastSeenAt: ss.LastSeenAt,
+			ExpiresAt:  ss.ExpiresAt,
+		},
+	}, nil
+}
+
+// ListAuditEvents implements api.Handler.
+func (hdl *Handler) ListAuditEvents(ctx context.Context) (api.ListAuditEventsRes, error) {
+	ss, ok := auth.FromContext(ctx)
+	if !ok {
+		return &api.ErrorResponse{
+			Message: "login is required",
+		}, nil
+	}
+
+	events := hdl.audit.List(ctx, ss.UserID)
+
+	res := make(api.ListAuditEventsOKApplicationJSON, 0, len(events))
+
+	for _, ev := range events {
+		event := api.AuditEvent{
+			Time: ev.Time,
+			Type: string(ev.Type),
+		}
+
+		if len(ev.CredentialID) > 0 {
+			event.CredentialId = api.NewOptString(base64.RawURLEncoding.EncodeToString(ev.CredentialID))
+		}
+
+		if ev.Detail != "" {
+			event.Detail = api.NewOptString(ev.Detail)
+		}
+
+		res = append(res, event)
+	}
+
+	return &res, nil
+}
+
+// GetBackupStatus implements api.Handler.
+func (hdl *Handler) GetBackupStatus(ctx context.Context) (api.GetBackupStatusRes, error) {
+	ss, ok := auth.FromContext(ctx)
+	if !ok {
+		return &api.GetBackupStatusUnauthorized{
+			Message: "login is required",
+		}, nil
+	}
+
+	creds, err := hdl.store.ListCredentials(ctx, ss.UserID)
+	if err != nil {
+		return &api.GetBackupStatusInternalServerError{
+			Message: fmt.Sprintf("failed to list credentials. error: %s", err),
+		}, nil
+	}
+
+	res := &api.BackupStatus{
+		Credentials: len(creds),
+	}
+
+	for _, cred := range creds {
+		if cred.Credential.Flags.BackupEligible {
+			res.BackupEligible++
+		}
+
+		if cred.Credential.Flags.BackupState {
+			res.BackedUp++
+		}
+	}
+
+	// NOTE: 同期できるパスキーが一つもなければ端末を失くすとログインできなくなる
+	res.DeviceBoundOnly = res.Credentials > 0 && res.BackupEligible == 0
+
+	return res, nil
+}
+
+// Logout implements api.Handler.
+func (hdl *Handler) Logout(ctx context.Context) (*api.LogoutNoContent, error) {
+	var id string
+
+	if ss, ok := auth.FromContext(ctx); ok {
+		id = ss.ID
+	}
+
+	cookie := hdl.auth.Logout(id)
+
+	return &api.LogoutNoContent{
+		SetCookie: api.NewOptString(cookie.String()),
+	}, nil
+}
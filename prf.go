@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/extension"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/store"
+)
+
+// prfSalts returns the prf salts of the credentials of the user keyed by the base64url credential id. Credentials
+// registered before prf was enabled get a salt here, and credentials known not to support prf are skipped.
+func (hdl *Handler) prfSalts(ctx context.Context, userID []byte) (map[string][]byte, error) {
+	creds, err := hdl.store.ListCredentials(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credentials. error: %w", err)
+	}
+
+	salts := make(map[string][]byte, len(creds))
+
+	for _, cred := range creds {
+		if supported := cred.Extensions.PRF; supported != nil && !*supported {
+			continue
+		}
+
+		salt := cred.PRFSalt
+
+		if len(salt) == 0 {
+			generated, err := extension.NewPRFSalt()
+			if err != nil {
+				return nil, err
+			}
+
+			// NOTE: 同時にログインが始まっても同じソルトを使うように、まだ無い場合だけストアの中で設定する
+			if err := hdl.store.UpdateCredential(ctx, cred.Credential.ID, func(cred *store.Credential) error {
+				if len(cred.PRFSalt) == 0 {
+					cred.PRFSalt = generated
+				}
+
+				salt = cred.PRFSalt
+
+				return nil
+			}); err != nil {
+				return nil, fmt.Errorf("failed to update credential. error: %w", err)
+			}
+		}
+
+		salts[base64.RawURLEncoding.EncodeToString(cred.Credential.ID)] = salt
+	}
+
+	return salts, nil
+}
+
+// confirmPRF records that the client got the prf results of the credential at login. A login without them is not
+// recorded, as it depends on the client and not on the credential.
+func (hdl *Handler) confirmPRF(ctx context.Context, credentialID []byte) error {
+	enabled := true
+
+	if err := hdl.store.UpdateCredential(ctx, credentialID, func(cred *store.Credential) error {
+		cred.Extensions.PRF = &enabled
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to update credential. error: %w", err)
+	}
+
+	return nil
+}
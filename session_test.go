@@ -0,0 +1,184 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/api"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/auth"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/keyring"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/replay"
+)
+
+func newSessionHandler(t *testing.T) *Handler {
+	t.Helper()
+
+	key, err := keyring.Generate("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kr, err := keyring.New(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &Handler{
+		keyring:    kr,
+		challenges: replay.NewRegistry(),
+	}
+}
+
+func TestSaveSessionExpires(t *testing.T) {
+	tests := []struct {
+		name    string
+		expires time.Time
+		timeout time.Duration
+		want    time.Duration
+	}{
+		{
+			name:    "timeout of the options",
+			timeout: 10 * time.Minute,
+			want:    10 * time.Minute,
+		},
+		{
+			name: "no timeout",
+			want: sessionTTL,
+		},
+		{
+			name:    "expiry of the session",
+			expires: time.Now().Add(time.Minute),
+			timeout: 10 * time.Minute,
+			want:    time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			hdl := newSessionHandler(t)
+
+			value, err := hdl.saveSession(context.Background(), &Session{
+				SessionData: webauthn.SessionData{Challenge: tt.name, Expires: tt.expires},
+				Ceremony:    ceremonyLogin,
+			}, tt.timeout)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			session, err := hdl.takeSession(context.Background(), value, ceremonyLogin)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := time.Until(session.Expires); got > tt.want || got < tt.want-time.Minute/2 {
+				t.Errorf("session expires in %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTakeSessionCeremony(t *testing.T) {
+	hdl := newSessionHandler(t)
+
+	value, err := hdl.saveSession(context.Background(), &Session{
+		SessionData: webauthn.SessionData{Challenge: "challenge", UserID: []byte("user")},
+		Ceremony:    ceremonyLogin,
+	}, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := hdl.takeSession(context.Background(), value, ceremonyRegistration); !errors.Is(err, errSessionMismatch) {
+		t.Errorf("error = %v, want %v", err, errSessionMismatch)
+	}
+}
+
+func TestFinalizeAttestationSession(t *testing.T) {
+	owner := []byte("owner-handle")
+
+	tests := []struct {
+		name    string
+		session Session
+		login   *auth.Session
+	}{
+		{
+			name: "login session",
+			session: Session{
+				SessionData: webauthn.SessionData{UserID: owner},
+				Ceremony:    ceremonyLogin,
+			},
+		},
+		{
+			name: "usernameless login session",
+			session: Session{
+				Ceremony: ceremonyLogin,
+			},
+		},
+		{
+			name: "registration session without user",
+			session: Session{
+				Ceremony: ceremonyRegistration,
+			},
+		},
+		{
+			name: "registration session of a logged out user",
+			session: Session{
+				SessionData: webauthn.SessionData{UserID: owner},
+				Ceremony:    ceremonyRegistration,
+				LoggedIn:    true,
+			},
+		},
+		{
+			name: "registration session of another user",
+			session: Session{
+				SessionData: webauthn.SessionData{UserID: owner},
+				Ceremony:    ceremonyRegistration,
+				LoggedIn:    true,
+			},
+			login: &auth.Session{UserID: []byte("attacker-handle")},
+		},
+	}
+
+	for i, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			hdl := newSessionHandler(t)
+
+			ctx := context.Background()
+
+			if tt.login != nil {
+				ctx = auth.WithSession(ctx, tt.login)
+			}
+
+			tt.session.Challenge = fmt.Sprintf("challenge-%d", i)
+
+			value, err := hdl.saveSession(ctx, &tt.session, time.Minute)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// NOTE: セッションで拒否するのでクレデンシャルは読まれない
+			res, err := hdl.FinalizeAttestation(ctx, nil, api.FinalizeAttestationParams{Session: value})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			bad, ok := res.(*api.FinalizeAttestationBadRequest)
+			if !ok {
+				t.Fatalf("response = %T, want %T", res, bad)
+			}
+
+			if got := bad.Response.Code.Or(""); got != "session_mismatch" {
+				t.Errorf("code = %q, want %q", got, "session_mismatch")
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/auth"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/store"
+)
+
+// userIDLength is the length of the user handle. The spec allows at most 64 bytes and recommends using all of them.
+const userIDLength = 64
+
+var _ webauthn.User = (*User)(nil)
+
+type User struct {
+	ID          []byte
+	Name        string
+	DisplayName string
+	Credentials []webauthn.Credential
+}
+
+// WebAuthnCredentials implements webauthn.User.
+func (us *User) WebAuthnCredentials() []webauthn.Credential {
+	return us.Credentials
+}
+
+// WebAuthnDisplayName implements webauthn.User.
+func (us *User) WebAuthnDisplayName() string {
+	return us.DisplayName
+}
+
+// WebAuthnID implements webauthn.User.
+func (us *User) WebAuthnID() []byte {
+	return us.ID
+}
+
+// WebAuthnIcon implements webauthn.User.
+func (us *User) WebAuthnIcon() string {
+	return ""
+}
+
+// WebAuthnName implements webauthn.User.
+func (us *User) WebAuthnName() string {
+	return us.Name
+}
+
+// exclusions returns the credentials the user has already registered so that the authenticator does not register
+// them again.
+func (us *User) exclusions() []protocol.CredentialDescriptor {
+	descriptors := make([]protocol.CredentialDescriptor, 0, len(us.Credentials))
+
+	for _, cred := range us.Credentials {
+		descriptors = append(descriptors, cred.Descriptor())
+	}
+
+	return descriptors
+}
+
+// newUser returns a user with a random user handle. The user is not stored until the registration is finalized.
+func (hdl *Handler) newUser(ctx context.Context, name string, displayName string) (*User, error) {
+	if _, err := hdl.store.FindUserByName(ctx, name); err == nil {
+		return nil, store.ErrAlreadyExists
+	}
+
+	id := make([]byte, userIDLength)
+
+	if _, err := io.ReadFull(rand.Reader, id); err != nil {
+		return nil, fmt.Errorf("failed to read random. error: %w", err)
+	}
+
+	return &User{
+		ID:          id,
+		Name:        name,
+		DisplayName: displayName,
+	}, nil
+}
+
+// registeringUser returns the user a passkey is registered for. A logged in user giving its own name adds a
+// passkey to its account, otherwise a new user is returned.
+func (hdl *Handler) registeringUser(ctx context.Context, name string, displayName string) (*User, error) {
+	if ss, ok := auth.FromContext(ctx); ok {
+		user, err := hdl.findUser(ctx, ss.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		if user.Name == name {
+			return user, nil
+		}
+	}
+
+	return hdl.newUser(ctx, name, displayName)
+}
+
+// findUser loads the user and the credentials it owns from the store.
+func (hdl *Handler) findUser(ctx context.Context, id []byte) (*User, error) {
+	user, err := hdl.store.FindUser(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user. error: %w", err)
+	}
+
+	return hdl.withCredentials(ctx, user)
+}
+
+// findUserByName loads the user with the name and the credentials it owns from the store.
+func (hdl *Handler) findUserByName(ctx context.Context, name string) (*User, error) {
+	user, err := hdl.store.FindUserByName(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user %s. error: %w", name, err)
+	}
+
+	return hdl.withCredentials(ctx, user)
+}
+
+func (hdl *Handler) withCredentials(ctx context.Context, user *store.User) (*User, error) {
+	creds, err := hdl.store.ListCredentials(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credentials. error: %w", err)
+	}
+
+	us := &User{
+		ID:          user.ID,
+		Name:        user.Name,
+		DisplayName: user.DisplayName,
+	}
+
+	for _, cred := range creds {
+		us.Credentials = append(us.Credentials, cred.Credential)
+	}
+
+	return us, nil
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/api"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/audit"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/auth"
+	"github.com/otakakot/sample-go-webauthn-passkey/internal/store"
+)
+
+var (
+	errLastVaultKey    = errors.New("the last credential holding the vault key cannot be deleted. wrap the key for another passkey or discard the vault first")
+	errPRFNotConfirmed = errors.New("prf is not confirmed for the credential of the login session")
+)
+
+// vaultKeyMismatchError refuses a vault key other than the one wrapped for the other credentials of the user.
+type vaultKeyMismatchError struct {
+	keyID string
+}
+
+// Error implements error.
+func (e *vaultKeyMismatchError) Error() string {
+	return fmt.Sprintf("the vault key %s is wrapped for the other credentials", e.keyID)
+}
+
+// GetVaultKey implements api.Handler.
+func (hdl *Handler) GetVaultKey(ctx context.Context) (api.GetVaultKeyRes, error) {
+	ss, ok := auth.FromContext(ctx)
+	if !ok {
+		return &api.GetVaultKeyUnauthorized{
+			Message: "login is required",
+		}, nil
+	}
+
+	// NOTE: 鍵はログインに使ったクレデンシャルの分しか返さない。prf の出力はそのログインでしか得られない
+	cred, err := hdl.store.FindCredential(ctx, ss.CredentialID)
+	if err != nil {
+		return &api.GetVaultKeyInternalServerError{
+			Message: fmt.Sprintf("failed to find credential. error: %s", err),
+		}, nil
+	}
+
+	if cred.VaultKey == nil {
+		return &api.GetVaultKeyNotFound{
+			Code:    api.NewOptString("vault_key_missing"),
+			Message: "the vault key is not wrapped for the credential of the login session",
+		}, nil
+	}
+
+	res := vaultKeyResponse(cred)
+
+	return &res, nil
+}
+
+// PutVaultKey implements api.Handler.
+func (hdl *Handler) PutVaultKey(ctx context.Context, req *api.PutVaultKeyRequest) (api.PutVaultKeyRes, error) {
+	ss, ok := auth.FromContext(ctx)
+	if !ok {
+		return &api.PutVaultKeyUnauthorized{
+			Message: "login is required",
+		}, nil
+	}
+
+	wrapped, err := base64.RawURLEncoding.DecodeString(req.WrappedKey)
+	if err != nil {
+		return &api.PutVaultKeyBadRequest{
+			Message: fmt.Sprintf("failed to decode wrapped key. error: %s", err),
+		}, nil
+	}
+
+	var updated store.Credential
+
+	// NOTE: 同時に別の鍵が登録されないように、他のクレデンシャルの確認と更新をストアの中で一度に行う
+	err = hdl.store.UpdateCredentialWithOthers(ctx, ss.CredentialID, func(cred *store.Credential, others []store.Credential) error {
+		if prf := cred.Extensions.PRF; prf == nil || !*prf {
+			return errPRFNotConfirmed
+		}
+
+		// NOTE: どのパスキーでも同じ保管庫を開けるように、他のクレデンシャルと同じ鍵しか受け付けない
+		for _, other := range others {
+			if other.VaultKey != nil && other.VaultKey.KeyID != req.KeyId {
+				return &vaultKeyMismatchError{keyID: other.VaultKey.KeyID}
+			}
+		}
+
+		cred.VaultKey = &store.VaultKey{
+			KeyID:     req.KeyId,
+			Wrapped:   wrapped,
+			CreatedAt: time.Now(),
+		}
+
+		updated = *cred
+
+		return nil
+	})
+
+	var mismatch *vaultKeyMismatchError
+
+	if errors.Is(err, errPRFNotConfirmed) {
+		return &api.PutVaultKeyBadRequest{
+			Message: err.Error(),
+		}, nil
+	}
+	if errors.As(err, &mismatch) {
+		return &api.PutVaultKeyConflict{
+			Code:    api.NewOptString("vault_key_mismatch"),
+			Message: mismatch.Error(),
+		}, nil
+	}
+	if err != nil {
+		return &api.PutVaultKeyInternalServerError{
+			Message: fmt.Sprintf("failed to update credential. error: %s", err),
+		}, nil
+	}
+
+	if err := hdl.audit.Record(ctx, audit.Event{
+		Type:         audit.TypeVaultKeyWrapped,
+		UserID:       ss.UserID,
+		CredentialID: ss.CredentialID,
+		Detail:       fmt.Sprintf("key id: %s", req.KeyId),
+	}); err != nil {
+		return &api.PutVaultKeyInternalServerError{
+			Message: fmt.Sprintf("failed to record audit event. error: %s", err),
+		}, nil
+	}
+
+	res := vaultKeyResponse(&updated)
+
+	return &res, nil
+}
+
+// DiscardVault implements api.Handler.
+func (hdl *Handler) DiscardVault(ctx context.Context) (api.DiscardVaultRes, error) {
+	ss, ok := auth.FromContext(ctx)
+	if !ok {
+		return &api.DiscardVaultUnauthorized{
+			Message: "login is required",
+		}, nil
+	}
+
+	creds, err := hdl.store.ListCredentials(ctx, ss.UserID)
+	if err != nil {
+		return &api.DiscardVaultInternalServerError{
+			Message: fmt.Sprintf("failed to list credentials. error: %s", err),
+		}, nil
+	}
+
+	for _, cred := range creds {
+		if cred.VaultKey == nil {
+			continue
+		}
+
+		if err := hdl.store.UpdateCredential(ctx, cred.Credential.ID, func(cred *store.Credential) error {
+			cred.VaultKey = nil
+
+			return nil
+		}); err != nil && !errors.Is(err, store.ErrNotFound) {
+			return &api.DiscardVaultInternalServerError{
+				Message: fmt.Sprintf("failed to update credential. error: %s", err),
+			}, nil
+		}
+	}
+
+	if err := hdl.audit.Record(ctx, audit.Event{
+		Type:         audit.TypeVaultDiscarded,
+		UserID:       ss.UserID,
+		CredentialID: ss.CredentialID,
+	}); err != nil {
+		return &api.DiscardVaultInternalServerError{
+			Message: fmt.Sprintf("failed to record audit event. error: %s", err),
+		}, nil
+	}
+
+	return &api.DiscardVaultNoContent{}, nil
+}
+
+func vaultKeyResponse(cred *store.Credential) api.VaultKey {
+	return api.VaultKey{
+		CredentialId: base64.RawURLEncoding.EncodeToString(cred.Credential.ID),
+		KeyId:        cred.VaultKey.KeyID,
+		WrappedKey:   base64.RawURLEncoding.EncodeToString(cred.VaultKey.Wrapped),
+		CreatedAt:    cred.VaultKey.CreatedAt,
+	}
+}